@@ -0,0 +1,210 @@
+package cronexpr
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CalendarMonth is the firing pattern of an expression over a calendar month.
+type CalendarMonth struct {
+	Year  int        `json:"year"`
+	Month time.Month `json:"month"`
+	// Counts holds the number of times the expression fires on each day of
+	// the month; Counts[0] is the 1st.
+	Counts []int `json:"counts"`
+}
+
+// Calendar returns the firing pattern of the cron expression over the given
+// month in loc. The days come from the same computation as Next, so L, W, #
+// and the union of restricted day-of-month and day-of-week fields are
+// reflected exactly, as are daylight saving transitions.
+func (expr *Expression) Calendar(year int, month time.Month, loc *time.Location) CalendarMonth {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	cm := CalendarMonth{Year: year, Month: month, Counts: make([]int, last)}
+	for _, day := range expr.firingDays(year, month) {
+		for _, n := range expr.hourCounts(year, month, day, loc, time.Time{}, time.Time{}) {
+			cm.Counts[day-1] += n
+		}
+	}
+	return cm
+}
+
+// CalendarYear returns the firing pattern of the cron expression over each
+// month of the given year in loc.
+func (expr *Expression) CalendarYear(year int, loc *time.Location) []CalendarMonth {
+	months := make([]CalendarMonth, 0, 12)
+	for month := time.January; month <= time.December; month++ {
+		months = append(months, expr.Calendar(year, month, loc))
+	}
+	return months
+}
+
+// Total returns the number of times the expression fires in the month.
+func (cm CalendarMonth) Total() int {
+	total := 0
+	for _, n := range cm.Counts {
+		total += n
+	}
+	return total
+}
+
+// calendarCell is the width of a day in a rendered month.
+const calendarCell = 6
+
+// String renders the month as a grid of weeks starting on Sunday. Days on
+// which the expression fires are marked with * and have their fire count on
+// the line below.
+func (cm CalendarMonth) String() string {
+	var b strings.Builder
+	title := fmt.Sprintf("%s %d", cm.Month, cm.Year)
+	width := calendarCell * daysPerWeek
+	fmt.Fprintf(&b, "%*s\n", (width+len(title))/2, title)
+	for _, name := range descDayShortNames {
+		fmt.Fprintf(&b, "%*s", calendarCell, name)
+	}
+	b.WriteByte('\n')
+
+	lead := int(time.Date(cm.Year, cm.Month, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	for start := 1 - lead; start <= len(cm.Counts); start += daysPerWeek {
+		var days, counts strings.Builder
+		for day := start; day < start+daysPerWeek; day++ {
+			if day < 1 || day > len(cm.Counts) {
+				days.WriteString(strings.Repeat(" ", calendarCell))
+				counts.WriteString(strings.Repeat(" ", calendarCell))
+				continue
+			}
+			mark, count := "", ""
+			if n := cm.Counts[day-1]; n > 0 {
+				mark, count = "*", fmt.Sprint(n)
+			}
+			fmt.Fprintf(&days, "%*s", calendarCell, mark+fmt.Sprint(day))
+			fmt.Fprintf(&counts, "%*s", calendarCell, count)
+		}
+		b.WriteString(strings.TrimRight(days.String(), " "))
+		b.WriteByte('\n')
+		if line := strings.TrimRight(counts.String(), " "); line != "" {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Heatmap counts the times an expression fires in a window by weekday and
+// hour.
+type Heatmap struct {
+	From, To time.Time
+	// Counts[weekday][hour] is the number of times the expression fires in
+	// that wall-clock hour of that weekday, in the location of From.
+	Counts [daysPerWeek][24]int
+}
+
+// Heatmap returns the times the cron expression fires in [from, to), by
+// weekday and hour in the location of from.
+func (expr *Expression) Heatmap(from, to time.Time) *Heatmap {
+	h := &Heatmap{From: from, To: to}
+	loc := from.Location()
+	to = to.In(loc)
+	// Walk the calendar dates of the window, held in UTC to avoid
+	// daylight saving arithmetic.
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	var days []int
+	month := time.Month(0)
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if day.Month() != month {
+			month = day.Month()
+			days = expr.firingDays(day.Year(), month)
+		}
+		if _, ok := slices.BinarySearch(days, day.Day()); !ok {
+			continue
+		}
+		counts := expr.hourCounts(day.Year(), day.Month(), day.Day(), loc, from, to)
+		for hour, n := range counts {
+			h.Counts[day.Weekday()][hour] += n
+		}
+	}
+	return h
+}
+
+// heatShades are the characters for increasing heat, from none to the
+// maximum count.
+var heatShades = []string{" ", ".", ":", "*", "#"}
+
+// String renders the heatmap as a grid of weekdays by hour, shading each
+// hour by its count relative to the busiest hour.
+func (h *Heatmap) String() string {
+	peak := 0
+	for _, hours := range h.Counts {
+		peak = max(peak, slices.Max(hours[:]))
+	}
+	var b strings.Builder
+	b.WriteString("   ")
+	for hour := range 24 {
+		fmt.Fprintf(&b, " %02d", hour)
+	}
+	b.WriteByte('\n')
+	for weekday, hours := range h.Counts {
+		row := descDayShortNames[weekday]
+		for _, n := range hours {
+			shade := 0
+			if n > 0 {
+				// Spread 1..peak over the non-blank shades.
+				shade = 1 + (n*(len(heatShades)-1)-1)/peak
+			}
+			row += "  " + heatShades[shade]
+		}
+		b.WriteString(strings.TrimRight(row, " "))
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "busiest hour: %d runs; . : * # are quarters of that\n", peak)
+	return b.String()
+}
+
+// firingDays returns the days of the month on which the expression fires,
+// or nil if the year or month is excluded.
+func (expr *Expression) firingDays(year int, month time.Month) []int {
+	if expr.interval > 0 {
+		return makeIntRange(1, time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day())
+	}
+	if _, ok := slices.BinarySearch(expr.yearList, year); !ok {
+		return nil
+	}
+	if _, ok := slices.BinarySearch(expr.monthList, int(month)); !ok {
+		return nil
+	}
+	return expr.calculateActualDaysOfMonth(year, int(month))
+}
+
+// hourCounts returns the number of times the expression fires in each wall
+// hour of a firing day in loc, limited to [lo, hi) when those are not zero.
+//
+// A whole day without a daylight saving transition fires the full product of
+// the minute and second lists in each listed hour; other days, and rate()
+// schedules, are walked with Next.
+func (expr *Expression) hourCounts(year int, month time.Month, day int, loc *time.Location, lo, hi time.Time) (counts [24]int) {
+	start := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	end := time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+	if !lo.IsZero() && lo.After(start) {
+		start = lo.In(loc)
+	}
+	if !hi.IsZero() && hi.Before(end) {
+		end = hi.In(loc)
+	}
+	_, startOffset := start.Zone()
+	_, endOffset := end.Zone()
+	if expr.interval == 0 && start.Hour() == 0 && start.Minute() == 0 && start.Second() == 0 && start.Nanosecond() == 0 &&
+		end.Sub(start) == 24*time.Hour && startOffset == endOffset {
+		perHour := len(expr.minuteList) * len(expr.secondList)
+		for _, hour := range expr.hourList {
+			counts[hour] = perHour
+		}
+		return counts
+	}
+	for t := expr.Next(start.Add(-time.Nanosecond)); !t.IsZero() && t.Before(end); t = expr.Next(t) {
+		counts[t.Hour()]++
+	}
+	return counts
+}
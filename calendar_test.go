@@ -0,0 +1,99 @@
+package cronexpr_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestCalendar(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name  string
+		expr  string
+		year  int
+		month time.Month
+		loc   *time.Location
+		want  map[int]int // day to count; other days must be 0
+	}{
+		{"LastFriday", "0 9 * * 5L", 2026, time.October, time.UTC, map[int]int{30: 1}},
+		{"SecondMonday", "0 9 * * 1#2", 2026, time.October, time.UTC, map[int]int{12: 1}},
+		{"NearestWeekday", "0 9 1W * *", 2026, time.August, time.UTC, map[int]int{3: 1}},
+		{"Union", "0 0 13 * 5", 2026, time.February, time.UTC, map[int]int{6: 1, 13: 1, 20: 1, 27: 1}},
+		{"Hourly", "*/15 9-10 1 * *", 2026, time.May, time.UTC, map[int]int{1: 8}},
+		{"OtherMonth", "0 0 1 1 *", 2026, time.May, time.UTC, nil},
+		// 2026-03-08 has no 02:00 hour in New York; 02:xx runs once at 03:xx.
+		{"SpringForward", "*/30 2,3 8 3 *", 2026, time.March, ny, map[int]int{8: 2}},
+		// 2026-11-01 repeats 01:00-02:00; fixed times run once, hourly
+		// schedules in both copies.
+		{"FallBack", "30 1 1 11 *", 2026, time.November, ny, map[int]int{1: 1}},
+		{"FallBackHourly", "0 * 1 11 *", 2026, time.November, ny, map[int]int{1: 25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := cronexpr.MustParse(tt.expr).Calendar(tt.year, tt.month, tt.loc)
+			for i, n := range cm.Counts {
+				if want := tt.want[i+1]; n != want {
+					t.Errorf("day %d fires %d times, want %d", i+1, n, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarMonth_String(t *testing.T) {
+	cm := cronexpr.MustParse("0 9,17 * * 1#2,5L").Calendar(2026, time.October, time.UTC)
+	const want = `               October 2026
+   Sun   Mon   Tue   Wed   Thu   Fri   Sat
+                             1     2     3
+     4     5     6     7     8     9    10
+    11   *12    13    14    15    16    17
+           2
+    18    19    20    21    22    23    24
+    25    26    27    28    29   *30    31
+                                   2
+`
+	if got := cm.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+	if cm.Total() != 4 {
+		t.Errorf("Total() = %d, want 4", cm.Total())
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	expr := cronexpr.MustParse("*/30 9-10 * * MON-FRI")
+	from := time.Date(2026, 10, 12, 9, 30, 0, 0, time.UTC) // Monday
+	to := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)   // next Monday
+	h := expr.Heatmap(from, to)
+
+	// The window starts after Monday 09:00 and ends before Monday 10:00;
+	// each bound cuts one run from one Monday.
+	want := map[[2]int]int{
+		{1, 9}: 3, {1, 10}: 2,
+	}
+	for wd := time.Tuesday; wd <= time.Friday; wd++ {
+		want[[2]int{int(wd), 9}] = 2
+		want[[2]int{int(wd), 10}] = 2
+	}
+	for wd, hours := range h.Counts {
+		for hour, n := range hours {
+			if n != want[[2]int{wd, hour}] {
+				t.Errorf("%v %02d:00 = %d, want %d", time.Weekday(wd), hour, n, want[[2]int{wd, hour}])
+			}
+		}
+	}
+
+	s := h.String()
+	if lines := strings.Split(strings.TrimSpace(s), "\n"); len(lines) != 9 {
+		t.Fatalf("String() has %d lines, want 9:\n%s", len(lines), s)
+	}
+	if !strings.Contains(s, "Mon                             #  *\n") {
+		t.Errorf("String() =\n%s", s)
+	}
+}
@@ -0,0 +1,48 @@
+package cronexpr
+
+import "time"
+
+// Clock is the source of time used by the timer-based facilities in this
+// package. SystemClock is used when none is supplied; tests can substitute
+// cronexprtest.FakeClock to advance time manually.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a Timer that fires after at least duration d.
+	NewTimer(d time.Duration) Timer
+	// After waits for duration d to elapse and then sends the current time on
+	// the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// Timer is the Clock counterpart of time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer has
+	// already fired or been stopped.
+	Stop() bool
+	// Reset changes the timer to fire after duration d. It returns true if the
+	// timer had been active.
+	Reset(d time.Duration) bool
+}
+
+// SystemClock is a Clock backed by the standard time package.
+type SystemClock struct{}
+
+// Now returns time.Now().
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// NewTimer wraps time.NewTimer.
+func (SystemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+// After wraps time.After.
+func (SystemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+type systemTimer struct {
+	t *time.Timer
+}
+
+func (st systemTimer) C() <-chan time.Time        { return st.t.C }
+func (st systemTimer) Stop() bool                 { return st.t.Stop() }
+func (st systemTimer) Reset(d time.Duration) bool { return st.t.Reset(d) }
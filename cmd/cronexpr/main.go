@@ -0,0 +1,487 @@
+// Command cronexpr evaluates cron expressions with the same code the
+// cronexpr package uses to schedule them.
+//
+// Usage:
+//
+//	cronexpr next [-n N] [--from TIME] [--tz ZONE] [--json] [EXPR...]
+//	cronexpr prev [-n N] [--from TIME] [--tz ZONE] [--json] [EXPR...]
+//	cronexpr describe [--short] [--24h] [--literal] [--tz ZONE] [--source-tz ZONE] [--at TIME] [--json] [EXPR...]
+//	cronexpr validate [--json] [EXPR...]
+//	cronexpr matches [--tz ZONE] [--json] TIME [EXPR...]
+//	cronexpr calendar [--month YYYY-MM | --year YYYY] [--tz ZONE] [--json] [EXPR...]
+//	cronexpr heatmap [--from TIME] [--to TIME] [--tz ZONE] [--json] [EXPR...]
+//
+// Expressions are read from the arguments or, if there are none, one per line
+// from standard input, skipping blank lines and # comments. Amazon
+// EventBridge schedules written as cron(...) or rate(...) are accepted too.
+//
+// The exit status is 0 on success, 1 if an expression is invalid or, for
+// matches, does not match, and 2 for usage errors.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+// Exit statuses.
+const (
+	exitOK    = 0
+	exitFail  = 1
+	exitUsage = 2
+)
+
+// timeFormat is used for times in text output; JSON output uses RFC 3339.
+const timeFormat = "Mon 2006-01-02 15:04:05 MST"
+
+// inputFormats are the layouts accepted for --from, --at and matches times,
+// tried in order. Layouts without a zone are read in the --tz zone, or the
+// --source-tz zone for --at.
+var inputFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// cmdContext carries a parsed subcommand invocation.
+type cmdContext struct {
+	args []string // expressions
+	json bool
+	// eval computes the result for a valid expression and reports whether
+	// it counts as a success.
+	eval func(src string, expr *cronexpr.Expression) (any, bool)
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, usage)
+		return exitUsage
+	}
+	var ctx *cmdContext
+	var err error
+	fs := flag.NewFlagSet("cronexpr "+args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	switch args[0] {
+	case "next", "prev":
+		ctx, err = stepFlags(fs, args[0] == "prev", args[1:])
+	case "describe":
+		ctx, err = describeFlags(fs, args[1:])
+	case "validate":
+		ctx, err = validateFlags(fs, args[1:])
+	case "matches":
+		ctx, err = matchesFlags(fs, args[1:])
+	case "calendar":
+		ctx, err = calendarFlags(fs, args[1:])
+	case "heatmap":
+		ctx, err = heatmapFlags(fs, args[1:])
+	case "help", "-h", "-help", "--help":
+		fmt.Fprintln(stdout, usage)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "cronexpr: unknown command %q\n%s\n", args[0], usage)
+		return exitUsage
+	}
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(stderr, "cronexpr %s: %v\n", args[0], err)
+		}
+		return exitUsage
+	}
+
+	sources := ctx.args
+	if len(sources) == 0 {
+		if sources, err = readExpressions(stdin); err != nil {
+			fmt.Fprintf(stderr, "cronexpr: %v\n", err)
+			return exitFail
+		}
+	}
+
+	status := exitOK
+	var results []any
+	for _, src := range sources {
+		expr, err := parse(src)
+		var result any
+		ok := err == nil
+		if err != nil {
+			result = errorResult{Expression: src, Error: newErrorJSON(err)}
+			if !ctx.json {
+				writeCaret(stderr, src, err)
+			}
+		} else {
+			result, ok = ctx.eval(src, expr)
+		}
+		if !ok {
+			status = exitFail
+		}
+		results = append(results, result)
+		if !ctx.json && err == nil {
+			writeText(stdout, result, len(sources) > 1)
+		}
+	}
+	if ctx.json {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if results == nil {
+			results = []any{}
+		}
+		if err := enc.Encode(results); err != nil {
+			fmt.Fprintf(stderr, "cronexpr: %v\n", err)
+			return exitFail
+		}
+	}
+	return status
+}
+
+// parse parses src as an EventBridge schedule when it is wrapped in cron()
+// or rate(), and as a cron expression otherwise.
+func parse(src string) (*cronexpr.Expression, error) {
+	if s := strings.TrimSpace(src); strings.HasPrefix(s, "cron(") || strings.HasPrefix(s, "rate(") {
+		return cronexpr.ParseEventBridge(src)
+	}
+	return cronexpr.Parse(src)
+}
+
+const usage = `usage:
+  cronexpr next [-n N] [--from TIME] [--tz ZONE] [--json] [EXPR...]
+  cronexpr prev [-n N] [--from TIME] [--tz ZONE] [--json] [EXPR...]
+  cronexpr describe [--short] [--24h] [--literal] [--tz ZONE] [--source-tz ZONE] [--at TIME] [--json] [EXPR...]
+  cronexpr validate [--json] [EXPR...]
+  cronexpr matches [--tz ZONE] [--json] TIME [EXPR...]
+  cronexpr calendar [--month YYYY-MM | --year YYYY] [--tz ZONE] [--json] [EXPR...]
+  cronexpr heatmap [--from TIME] [--to TIME] [--tz ZONE] [--json] [EXPR...]
+
+Expressions are read from standard input, one per line, when none are given.
+EventBridge cron(...) and rate(...) schedules are accepted too.`
+
+// stepFlags parses the flags of next and prev.
+func stepFlags(fs *flag.FlagSet, backward bool, args []string) (*cmdContext, error) {
+	n := fs.Uint("n", 5, "number of times to list")
+	from := fs.String("from", "", "start `time` (default now)")
+	tz := fs.String("tz", "Local", "time `zone` to evaluate and print in")
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now().In(loc)
+	if *from != "" {
+		if start, err = parseTime(*from, loc); err != nil {
+			return nil, err
+		}
+	}
+	return &cmdContext{
+		args: fs.Args(),
+		json: *asJSON,
+		eval: func(src string, expr *cronexpr.Expression) (any, bool) {
+			times := []time.Time{}
+			t := start
+			for range *n {
+				if backward {
+					t = expr.Prev(t)
+				} else {
+					t = expr.Next(t)
+				}
+				if t.IsZero() {
+					break
+				}
+				times = append(times, t)
+			}
+			return timesResult{Expression: src, Times: times}, true
+		},
+	}, nil
+}
+
+// describeFlags parses the flags of describe.
+func describeFlags(fs *flag.FlagSet, args []string) (*cmdContext, error) {
+	short := fs.Bool("short", false, "use abbreviated day and month names")
+	hour24 := fs.Bool("24h", false, "show times on a 24-hour clock")
+	literal := fs.Bool("literal", false, "name days and months rather than use idioms such as \"weekdays\"")
+	tz := fs.String("tz", "UTC", "time `zone` to describe times in")
+	sourceTZ := fs.String("source-tz", "UTC", "time `zone` the schedule runs in")
+	at := fs.String("at", "", "reference `time` for time zone offsets (default now)")
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	target, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, err
+	}
+	source, err := time.LoadLocation(*sourceTZ)
+	if err != nil {
+		return nil, err
+	}
+	var ref time.Time
+	if *at != "" {
+		if ref, err = parseTime(*at, source); err != nil {
+			return nil, err
+		}
+	}
+	opts := &cronexpr.DescribeOptions{Short: *short, Hour24: *hour24, Literal: *literal, SourceLocation: source, TargetLocation: target, ReferenceTime: ref}
+	return &cmdContext{
+		args: fs.Args(),
+		json: *asJSON,
+		eval: func(src string, expr *cronexpr.Expression) (any, bool) {
+			return describeResult{Expression: src, Description: expr.Describe(opts)}, true
+		},
+	}, nil
+}
+
+// validateFlags parses the flags of validate.
+func validateFlags(fs *flag.FlagSet, args []string) (*cmdContext, error) {
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return &cmdContext{
+		args: fs.Args(),
+		json: *asJSON,
+		eval: func(src string, _ *cronexpr.Expression) (any, bool) {
+			return validResult{Expression: src, Valid: true}, true
+		},
+	}, nil
+}
+
+// matchesFlags parses the flags and time argument of matches.
+func matchesFlags(fs *flag.FlagSet, args []string) (*cmdContext, error) {
+	tz := fs.String("tz", "Local", "time `zone` of TIME when it has no offset")
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() == 0 {
+		return nil, errors.New("missing time")
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, err
+	}
+	at, err := parseTime(fs.Arg(0), loc)
+	if err != nil {
+		return nil, err
+	}
+	return &cmdContext{
+		args: fs.Args()[1:],
+		json: *asJSON,
+		eval: func(src string, expr *cronexpr.Expression) (any, bool) {
+			matches := expr.Matches(at)
+			return matchResult{Expression: src, Time: at, Matches: matches}, matches
+		},
+	}, nil
+}
+
+// calendarFlags parses the flags of calendar.
+func calendarFlags(fs *flag.FlagSet, args []string) (*cmdContext, error) {
+	month := fs.String("month", "", "`month` to show, as YYYY-MM (default this month)")
+	year := fs.Int("year", 0, "`year` to show instead of a single month")
+	tz := fs.String("tz", "Local", "time `zone` to count runs in")
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, err
+	}
+	first := time.Now().In(loc)
+	if *month != "" {
+		if first, err = time.Parse("2006-01", *month); err != nil {
+			return nil, fmt.Errorf("cannot parse month %q; use YYYY-MM", *month)
+		}
+	}
+	return &cmdContext{
+		args: fs.Args(),
+		json: *asJSON,
+		eval: func(src string, expr *cronexpr.Expression) (any, bool) {
+			months := []cronexpr.CalendarMonth{expr.Calendar(first.Year(), first.Month(), loc)}
+			if *year != 0 {
+				months = expr.CalendarYear(*year, loc)
+			}
+			return calendarResult{Expression: src, Months: months}, true
+		},
+	}, nil
+}
+
+// heatmapFlags parses the flags of heatmap.
+func heatmapFlags(fs *flag.FlagSet, args []string) (*cmdContext, error) {
+	from := fs.String("from", "", "start `time` of the window (default now)")
+	to := fs.String("to", "", "end `time` of the window (default four weeks after --from)")
+	tz := fs.String("tz", "Local", "time `zone` to count runs in")
+	asJSON := fs.Bool("json", false, "write JSON")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now().In(loc)
+	if *from != "" {
+		if start, err = parseTime(*from, loc); err != nil {
+			return nil, err
+		}
+	}
+	end := start.AddDate(0, 0, 28)
+	if *to != "" {
+		if end, err = parseTime(*to, loc); err != nil {
+			return nil, err
+		}
+	}
+	return &cmdContext{
+		args: fs.Args(),
+		json: *asJSON,
+		eval: func(src string, expr *cronexpr.Expression) (any, bool) {
+			h := expr.Heatmap(start, end)
+			return heatmapResult{Expression: src, From: h.From, To: h.To, Counts: h.Counts, heatmap: h}, true
+		},
+	}, nil
+}
+
+// parseTime reads s in one of inputFormats, in loc unless s has an offset.
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	if s == "now" {
+		return time.Now().In(loc), nil
+	}
+	for _, layout := range inputFormats {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse time %q; use RFC 3339 or 2006-01-02 15:04", s)
+}
+
+// readExpressions reads one expression per line, skipping blank lines and
+// comments.
+func readExpressions(r io.Reader) ([]string, error) {
+	var exprs []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		exprs = append(exprs, line)
+	}
+	return exprs, sc.Err()
+}
+
+// writeCaret reports a parse error with a caret line under the offending
+// text.
+func writeCaret(w io.Writer, src string, err error) {
+	fmt.Fprintf(w, "error: %v\n", err)
+	var pe *cronexpr.ParseError
+	if !errors.As(err, &pe) {
+		return
+	}
+	fmt.Fprintf(w, "  %s\n  %s%s\n", pe.Input, strings.Repeat(" ", pe.Offset), strings.Repeat("^", max(pe.Length, 1)))
+}
+
+// writeText writes a result in text form, under a heading naming the
+// expression if there are several.
+func writeText(w io.Writer, result any, heading bool) {
+	var src string
+	var lines []string
+	switch r := result.(type) {
+	case timesResult:
+		src = r.Expression
+		for _, t := range r.Times {
+			lines = append(lines, t.Format(timeFormat))
+		}
+	case describeResult:
+		src, lines = r.Expression, []string{r.Description}
+	case validResult:
+		src, lines = r.Expression, []string{"valid"}
+	case matchResult:
+		src, lines = r.Expression, []string{fmt.Sprint(r.Matches)}
+	case calendarResult:
+		src = r.Expression
+		for i, cm := range r.Months {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, strings.Split(strings.TrimSuffix(cm.String(), "\n"), "\n")...)
+		}
+	case heatmapResult:
+		src, lines = r.Expression, strings.Split(strings.TrimSuffix(r.heatmap.String(), "\n"), "\n")
+	}
+	if heading {
+		fmt.Fprintf(w, "%s:\n", src)
+		for i := range lines {
+			lines[i] = "  " + lines[i]
+		}
+	}
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+}
+
+// Results, as written by --json.
+type (
+	timesResult struct {
+		Expression string      `json:"expression"`
+		Times      []time.Time `json:"times"`
+	}
+	describeResult struct {
+		Expression  string `json:"expression"`
+		Description string `json:"description"`
+	}
+	validResult struct {
+		Expression string `json:"expression"`
+		Valid      bool   `json:"valid"`
+	}
+	matchResult struct {
+		Expression string    `json:"expression"`
+		Time       time.Time `json:"time"`
+		Matches    bool      `json:"matches"`
+	}
+	calendarResult struct {
+		Expression string                   `json:"expression"`
+		Months     []cronexpr.CalendarMonth `json:"months"`
+	}
+	heatmapResult struct {
+		Expression string    `json:"expression"`
+		From       time.Time `json:"from"`
+		To         time.Time `json:"to"`
+		// Counts is indexed by weekday, Sunday first, then hour.
+		Counts  [7][24]int `json:"counts"`
+		heatmap *cronexpr.Heatmap
+	}
+	errorResult struct {
+		Expression string    `json:"expression"`
+		Valid      bool      `json:"valid"`
+		Error      errorJSON `json:"error"`
+	}
+	errorJSON struct {
+		Message string `json:"message"`
+		Offset  int    `json:"offset"`
+		Length  int    `json:"length"`
+	}
+)
+
+func newErrorJSON(err error) errorJSON {
+	ej := errorJSON{Message: err.Error()}
+	var pe *cronexpr.ParseError
+	if errors.As(err, &pe) {
+		ej.Offset, ej.Length = pe.Offset, pe.Length
+	}
+	return ej
+}
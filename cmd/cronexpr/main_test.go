@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantStatus int
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:    "Next",
+			args:    []string{"next", "-n", "2", "--tz", "America/New_York", "--from", "2026-03-07", "30 2 * * *"},
+			wantOut: "Sat 2026-03-07 02:30:00 EST\nSun 2026-03-08 03:30:00 EDT\n",
+		},
+		{
+			name:    "Prev",
+			args:    []string{"prev", "-n", "1", "--tz", "UTC", "--from", "2026-10-19 09:00", "0 9 * * MON-FRI"},
+			wantOut: "Fri 2026-10-16 09:00:00 UTC\n",
+		},
+		{
+			name:    "Describe",
+			args:    []string{"describe", "--short", "0 9 * * MON-FRI"},
+			wantOut: "At 9AM, weekdays\n",
+		},
+		{
+			name:    "DescribeLiteral",
+			args:    []string{"describe", "--short", "--literal", "0 9 * * MON-FRI"},
+			wantOut: "At 9AM, Mon–Fri\n",
+		},
+		{
+			name:    "Describe24h",
+			args:    []string{"describe", "--24h", "30 17 * * MON-FRI"},
+			wantOut: "At 17:30, on weekdays\n",
+		},
+		{
+			name:    "DescribeAt",
+			args:    []string{"describe", "--source-tz", "Europe/Berlin", "--at", "2026-07-15", "0 9 * * *"},
+			wantOut: "At 7:00 AM (an hour later for part of the year)\n",
+		},
+		{
+			name:    "ValidateStdin",
+			args:    []string{"validate"},
+			stdin:   "# schedules\n0 0 * * *\n\n@hourly\n",
+			wantOut: "0 0 * * *:\n  valid\n@hourly:\n  valid\n",
+		},
+		{
+			name:       "ValidateCaret",
+			args:       []string{"validate", "0 9 * * MON#9"},
+			wantStatus: exitFail,
+			wantErr:    "error: syntax error in day-of-week field: 'MON#9'\n  0 9 * * MON#9\n          ^^^^^\n",
+		},
+		{
+			name:    "EventBridge",
+			args:    []string{"describe", "--short", "cron(0 9 ? * MON-FRI *)", "rate(5 minutes)"},
+			wantOut: "cron(0 9 ? * MON-FRI *):\n  At 9AM, weekdays\nrate(5 minutes):\n  Every 5 mins\n",
+		},
+		{
+			name:       "EventBridgeCaret",
+			args:       []string{"validate", "cron(0 9 * * MON *)"},
+			wantStatus: exitFail,
+			wantErr:    "error: exactly one of day-of-month and day-of-week must be ?\n  cron(0 9 * * MON *)\n           ^^^^^^^\n",
+		},
+		{
+			name:    "Matches",
+			args:    []string{"matches", "--tz", "UTC", "2026-10-19T09:00", "0 9 * * MON-FRI"},
+			wantOut: "true\n",
+		},
+		{
+			name:       "NoMatch",
+			args:       []string{"matches", "--tz", "UTC", "2026-10-18T09:00", "0 9 * * MON-FRI"},
+			wantStatus: exitFail,
+			wantOut:    "false\n",
+		},
+		{
+			name:       "UnknownCommand",
+			args:       []string{"explain"},
+			wantStatus: exitUsage,
+		},
+		{
+			name:       "BadZone",
+			args:       []string{"next", "--tz", "Nowhere/Special", "* * * * *"},
+			wantStatus: exitUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d; stderr:\n%s", status, tt.wantStatus, stderr.String())
+			}
+			if tt.wantOut != "" && stdout.String() != tt.wantOut {
+				t.Errorf("stdout =\n%s\nwant\n%s", stdout.String(), tt.wantOut)
+			}
+			if tt.wantErr != "" && stderr.String() != tt.wantErr {
+				t.Errorf("stderr =\n%s\nwant\n%s", stderr.String(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_JSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"next", "--json", "-n", "2", "--tz", "UTC", "--from", "2026-01-01", "@daily", "0 9 * * MON#9"},
+		strings.NewReader(""), &stdout, &stderr)
+	if status != exitFail {
+		t.Errorf("status = %d, want %d", status, exitFail)
+	}
+	var results []struct {
+		Expression string   `json:"expression"`
+		Times      []string `json:"times"`
+		Error      *struct {
+			Message        string
+			Offset, Length int
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		t.Fatalf("decoding %s: %v", stdout.String(), err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if got := strings.Join(results[0].Times, ","); got != "2026-01-02T00:00:00Z,2026-01-03T00:00:00Z" {
+		t.Errorf("times = %s", got)
+	}
+	if e := results[1].Error; e == nil || e.Offset != 8 || e.Length != 5 {
+		t.Errorf("error = %+v, want offset 8, length 5", e)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr = %s, want nothing with --json", stderr.String())
+	}
+}
+
+func TestRun_Calendar(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"calendar", "--json", "--year", "2026", "--tz", "UTC", "0 0 L 2 *"},
+		strings.NewReader(""), &stdout, &stderr)
+	if status != exitOK {
+		t.Fatalf("status = %d; stderr:\n%s", status, stderr.String())
+	}
+	var results []struct {
+		Months []struct {
+			Month  int   `json:"month"`
+			Counts []int `json:"counts"`
+		} `json:"months"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || len(results[0].Months) != 12 {
+		t.Fatalf("results = %+v", results)
+	}
+	feb := results[0].Months[1]
+	if feb.Month != 2 || len(feb.Counts) != 28 || feb.Counts[27] != 1 {
+		t.Errorf("February = %+v", feb)
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 // Expression represents a parsed cron expression. Use Parse or MustParse to create one.
 type Expression struct {
-	normalized             string // alias-expanded cron string, stored by Parse() for Describe()
+	normalized             string // alias-expanded source text, kept for diagnostics
 	secondList             []int
 	minuteList             []int
 	hourList               []int
@@ -19,13 +19,52 @@ type Expression struct {
 	lastDayOfMonth         bool
 	lastWorkdayOfMonth     bool
 	daysOfMonthRestricted  bool
-	actualDaysOfMonthList  []int
 	monthList              []int
 	daysOfWeek             map[int]bool
 	specificWeekDaysOfWeek map[int]bool
 	lastWeekDaysOfWeek     map[int]bool
 	daysOfWeekRestricted   bool
 	yearList               []int
+	interval               time.Duration // rate() schedules from ParseEventBridge; zero for cron
+	byClock                bool          // minute or hour field is * or a step: runs follow the clock through DST
+}
+
+// ParseError describes a malformed cron expression, locating the offending
+// part of the input for diagnostics.
+type ParseError struct {
+	// Input is the expression as passed to Parse.
+	Input string
+	// Offset is the byte offset in Input of the offending field entry, or
+	// len(Input) when fields are missing.
+	Offset int
+	// Length is the byte length of the offending text; zero when fields are
+	// missing.
+	Length int
+	// Msg describes the problem.
+	Msg string
+}
+
+func (e *ParseError) Error() string { return e.Msg }
+
+// newParseError locates err, returned while parsing field of the normalized
+// expression, in input.
+func newParseError(input, normalized string, field entrySpan, err error) *ParseError {
+	pe := &ParseError{
+		Input:  input,
+		Offset: field.start,
+		Length: field.end - field.start,
+		Msg:    err.Error(),
+	}
+	var ee *entryError
+	if errors.As(err, &ee) {
+		pe.Offset = field.start + ee.start
+		pe.Length = ee.end - ee.start
+	}
+	if normalized != input {
+		// Offsets into an expanded alias do not map back to the input.
+		pe.Offset, pe.Length = 0, len(input)
+	}
+	return pe
 }
 
 // MustParse returns a new Expression pointer. It expects a well-formed cron
@@ -38,8 +77,8 @@ func MustParse(cronLine string) *Expression {
 	return expr
 }
 
-// Parse returns a new Expression pointer. An error is returned if a malformed
-// cron expression is supplied.
+// Parse returns a new Expression pointer. An error of type *ParseError is
+// returned if a malformed cron expression is supplied.
 func Parse(cronLine string) (*Expression, error) {
 
 	// Maybe one of the built-in aliases is being used
@@ -50,10 +89,10 @@ func Parse(cronLine string) (*Expression, error) {
 		maxCronFields = 7
 	)
 
-	fields := strings.Fields(cron)
+	fields := splitFields(cron)
 	fieldCount := len(fields)
 	if fieldCount < minCronFields {
-		return nil, errors.New("missing field(s)")
+		return nil, &ParseError{Input: cronLine, Offset: len(cronLine), Msg: "missing field(s)"}
 	}
 	// ignore fields beyond 7th
 	if fieldCount > maxCronFields {
@@ -67,9 +106,9 @@ func Parse(cronLine string) (*Expression, error) {
 
 	// second field (optional)
 	if fieldCount == maxCronFields {
-		err = parseField(fields[field], secondDescriptor, &expr.secondList)
+		err = parseField(fields[field].text, secondDescriptor, &expr.secondList)
 		if err != nil {
-			return nil, err
+			return nil, newParseError(cronLine, cron, fields[field], err)
 		}
 		field++
 	} else {
@@ -77,45 +116,47 @@ func Parse(cronLine string) (*Expression, error) {
 	}
 
 	// minute field
-	err = parseField(fields[field], minuteDescriptor, &expr.minuteList)
+	err = parseField(fields[field].text, minuteDescriptor, &expr.minuteList)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(cronLine, cron, fields[field], err)
 	}
+	expr.byClock = strings.ContainsAny(fields[field].text, "*/")
 	field++
 
 	// hour field
-	err = parseField(fields[field], hourDescriptor, &expr.hourList)
+	err = parseField(fields[field].text, hourDescriptor, &expr.hourList)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(cronLine, cron, fields[field], err)
 	}
+	expr.byClock = expr.byClock || strings.ContainsAny(fields[field].text, "*/")
 	field++
 
 	// day of month field
-	err = expr.domFieldHandler(fields[field])
+	err = expr.domFieldHandler(fields[field].text)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(cronLine, cron, fields[field], err)
 	}
 	field++
 
 	// month field
-	err = parseField(fields[field], monthDescriptor, &expr.monthList)
+	err = parseField(fields[field].text, monthDescriptor, &expr.monthList)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(cronLine, cron, fields[field], err)
 	}
 	field++
 
 	// day of week field
-	err = expr.dowFieldHandler(fields[field])
+	err = expr.dowFieldHandler(fields[field].text)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(cronLine, cron, fields[field], err)
 	}
 	field++
 
 	// year field
 	if field < fieldCount {
-		err = parseField(fields[field], yearDescriptor, &expr.yearList)
+		err = parseField(fields[field].text, yearDescriptor, &expr.yearList)
 		if err != nil {
-			return nil, err
+			return nil, newParseError(cronLine, cron, fields[field], err)
 		}
 	} else {
 		expr.yearList = yearDescriptor.defaultList
@@ -127,6 +168,13 @@ func Parse(cronLine string) (*Expression, error) {
 // Next returns the closest time instant immediately following fromTime which
 // matches the cron expression.
 //
+// Around daylight saving transitions, Next follows vixie cron. Fixed times
+// that fall in a gap run once the clocks have jumped, and fixed times that
+// repeat when the clocks fall back run at their first occurrence only.
+// Expressions with * or a step in the minute or hour field follow the clock
+// instead: they skip times in a gap and run in both copies of a repeated
+// hour.
+//
 // The time.Location of the returned time instant is the same as that of
 // fromTime.
 //
@@ -137,7 +185,24 @@ func (expr *Expression) Next(fromTime time.Time) time.Time {
 	if fromTime.IsZero() {
 		return fromTime
 	}
+	if expr.interval > 0 {
+		return expr.nextInterval(fromTime)
+	}
+	if expr.byClock {
+		return expr.nextByClock(fromTime)
+	}
 
+	t := expr.next(fromTime)
+	// A wall-clock time repeated when daylight saving time ends resolves to
+	// its first occurrence, which may precede fromTime; step past it.
+	for !t.IsZero() && !t.After(fromTime) {
+		t = expr.nextSecond(t, expr.calculateActualDaysOfMonth(t.Year(), int(t.Month())))
+	}
+	return t
+}
+
+// next runs the field cascade for Next without the ordering guarantee.
+func (expr *Expression) next(fromTime time.Time) time.Time {
 	// Walk each field from year down to second. If any field doesn't match,
 	// advance to the next matching time for that field.
 	// year
@@ -159,46 +224,46 @@ func (expr *Expression) Next(fromTime time.Time) time.Time {
 		return expr.nextMonth(fromTime)
 	}
 
-	expr.actualDaysOfMonthList = expr.calculateActualDaysOfMonth(fromTime.Year(), int(fromTime.Month()))
-	if len(expr.actualDaysOfMonthList) == 0 {
+	days := expr.calculateActualDaysOfMonth(fromTime.Year(), int(fromTime.Month()))
+	if len(days) == 0 {
 		return expr.nextMonth(fromTime)
 	}
 
 	// day of month
 	v = fromTime.Day()
-	i, _ = slices.BinarySearch(expr.actualDaysOfMonthList, v)
-	if i == len(expr.actualDaysOfMonthList) {
+	i, _ = slices.BinarySearch(days, v)
+	if i == len(days) {
 		return expr.nextMonth(fromTime)
 	}
-	if v != expr.actualDaysOfMonthList[i] {
-		return expr.nextDayOfMonth(fromTime)
+	if v != days[i] {
+		return expr.nextDayOfMonth(fromTime, days)
 	}
 	// hour
 	v = fromTime.Hour()
 	i, _ = slices.BinarySearch(expr.hourList, v)
 	if i == len(expr.hourList) {
-		return expr.nextDayOfMonth(fromTime)
+		return expr.nextDayOfMonth(fromTime, days)
 	}
 	if v != expr.hourList[i] {
-		return expr.nextHour(fromTime)
+		return expr.nextHour(fromTime, days)
 	}
 	// minute
 	v = fromTime.Minute()
 	i, _ = slices.BinarySearch(expr.minuteList, v)
 	if i == len(expr.minuteList) {
-		return expr.nextHour(fromTime)
+		return expr.nextHour(fromTime, days)
 	}
 	if v != expr.minuteList[i] {
-		return expr.nextMinute(fromTime)
+		return expr.nextMinute(fromTime, days)
 	}
 	// second
 	v = fromTime.Second()
 	i, _ = slices.BinarySearch(expr.secondList, v)
 	if i == len(expr.secondList) {
-		return expr.nextMinute(fromTime)
+		return expr.nextMinute(fromTime, days)
 	}
 
-	return expr.nextSecond(fromTime)
+	return expr.nextSecond(fromTime, days)
 }
 
 // NextN returns a slice of the n closest time instants immediately following
@@ -220,8 +285,73 @@ func (expr *Expression) NextN(fromTime time.Time, n uint) []time.Time {
 			if n == 0 {
 				break
 			}
-			fromTime = expr.nextSecond(fromTime)
+			fromTime = expr.Next(fromTime)
 		}
 	}
 	return nextTimes
 }
+
+// Prev returns the closest time instant immediately preceding fromTime which
+// matches the cron expression.
+//
+// The time.Location of the returned time instant is the same as that of
+// fromTime.
+//
+// The zero value of time.Time is returned if no matching time instant exists
+// or if fromTime is itself a zero value.
+func (expr *Expression) Prev(fromTime time.Time) time.Time {
+	// Special case
+	if fromTime.IsZero() {
+		return fromTime
+	}
+	if expr.interval > 0 {
+		return expr.prevInterval(fromTime)
+	}
+	if expr.byClock {
+		return expr.prevByClock(fromTime)
+	}
+
+	loc := fromTime.Location()
+	wall := time.Date(fromTime.Year(), fromTime.Month(), fromTime.Day(),
+		fromTime.Hour(), fromTime.Minute(), fromTime.Second(), 0, time.UTC)
+	if fromTime.Nanosecond() > 0 {
+		// The current second itself precedes fromTime.
+		wall = wall.Add(time.Second)
+	}
+	// In the second copy of an hour repeated when the clocks fall back, the
+	// first copies of the wall times up to the length of the repeat later
+	// also precede fromTime, and runs match only first copies.
+	first := wallDate(fromTime.Year(), fromTime.Month(), fromTime.Day(),
+		fromTime.Hour(), fromTime.Minute(), fromTime.Second(), loc)
+	if repeat := fromTime.Truncate(time.Second).Sub(first); repeat > 0 {
+		wall = wall.Add(repeat)
+	}
+	for {
+		wall = expr.prev(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		// A time in a daylight saving gap runs after the jump, which may
+		// not precede fromTime; keep stepping back until one does.
+		t := wallDate(wall.Year(), wall.Month(), wall.Day(),
+			wall.Hour(), wall.Minute(), wall.Second(), loc)
+		if t.Before(fromTime) {
+			return t
+		}
+	}
+}
+
+// Matches reports whether the cron expression fires at t, that is, whether t
+// is one of the time instants returned by Next. Fractional seconds are
+// ignored.
+//
+// Like Next, Matches follows daylight saving transitions: a fixed time skipped
+// by the clocks matches at the instant it runs after the jump, and a repeated
+// fixed time matches only its first occurrence.
+func (expr *Expression) Matches(t time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+	t = t.Truncate(time.Second)
+	return expr.Prev(t.Add(time.Second)).Equal(t)
+}
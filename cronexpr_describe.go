@@ -1,11 +1,11 @@
 package cronexpr
 
 import (
-	"fmt"
-	"regexp"
+	"slices"
 	"strconv"
-	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // DescribeOptions controls how a cron expression is described.
@@ -16,6 +16,35 @@ type DescribeOptions struct {
 	SourceLocation *time.Location
 	// TargetLocation is the display timezone (nil = UTC).
 	TargetLocation *time.Location
+	// Locale is the BCP 47 tag of the description's language, such as "de"
+	// or "fr-CA". Built in are en, de, fr, es and ja; others can be added
+	// with RegisterLocale. Empty and unknown tags describe in English.
+	Locale string
+	// Translator, if set, is used instead of the Locale's translator.
+	Translator Translator
+	// Hour24 shows times on a 24-hour clock ("15:00" vs "3:00 PM") in
+	// languages that default to 12 hours.
+	Hour24 bool
+	// TimeFormat, if set, is a time.Format layout for times of day, such as
+	// "15h04", overriding Hour24 and the language's format.
+	TimeFormat string
+	// Seconds includes the second in times of day even when it is zero
+	// ("9:00:00 AM"). A single nonzero second is always shown.
+	Seconds bool
+	// ReferenceTime is the date whose offsets convert times from the
+	// SourceLocation to the TargetLocation (zero = now). When the offset
+	// between them changes during that year with daylight saving time, the
+	// description says so.
+	ReferenceTime time.Time
+	// Phrases overrides the translator's messages by key, such as
+	// "weekday-range" or "every-minutes.short", with templates in the form
+	// Translator describes. It also overrides idioms, such as
+	// "idiom-weekdays", or turns one off with an empty template.
+	Phrases map[string]string
+	// Literal turns off idioms, describing days 1-5 as "Monday–Friday"
+	// rather than "on weekdays", months 1,4,7,10 by name rather than
+	// "quarterly", and so on.
+	Literal bool
 }
 
 var (
@@ -28,482 +57,679 @@ var (
 )
 
 // Describe returns a human-readable description of the cron expression.
-// If opts is nil, defaults are used (long names, UTC timezone).
+// If opts is nil, defaults are used (long names, UTC timezone, English).
+// DescribeParts returns the same description as typed parts.
 func (expr *Expression) Describe(opts *DescribeOptions) string {
-	if opts == nil {
-		opts = &DescribeOptions{}
+	return expr.DescribeParts(opts).String()
+}
+
+// describe describes a cron expression's fields.
+func (d *describer) describe(expr *Expression) Description {
+	s := newDescSchedule(expr)
+	second, single := s.seconds.single()
+	d.second, d.style.Seconds = second, d.style.Seconds && single
+	var parts []Description
+	for _, g := range d.dayGroups(&s) {
+		parts = append(parts, d.describeSchedule(&g))
 	}
-	srcLoc := opts.SourceLocation
-	if srcLoc == nil {
-		srcLoc = time.UTC
+	desc := d.join(parts)
+	if years := d.describeYears(s.years); years != nil {
+		desc = d.phrase("with-years", 0, desc, years)
 	}
-	targetLoc := opts.TargetLocation
-	if targetLoc == nil {
-		targetLoc = time.UTC
+	if d.converted {
+		desc = d.withDST(desc)
 	}
+	return desc
+}
 
-	fields := descParseFields(expr.normalized)
-	if fields == nil {
-		return expr.normalized
-	}
+// describeSchedule describes the times and days of a schedule. Days of the
+// month, such as the 1st, L or 1#2, do not shift with times that convert to
+// another day, so such schedules are described in the source location.
+func (d *describer) describeSchedule(s *descSchedule) Description {
+	if (s.domRestricted || len(s.nthWeekdays)+len(s.lastWeekdays) > 0) && d.crossesMidnight(s) {
+		src := *d
+		src.target = d.src
+		return d.phrase("source-time", 0, src.describeSchedule(s), Description{textPart(d.src.String())})
+	}
+	s.businessHours = d.businessHours(s)
+	desc, dayOffset := d.describeTime(s)
+	if date := d.describeDate(s, dayOffset); date != nil {
+		desc = d.phrase("sentence", 0, desc, date)
+	}
+	return desc
+}
 
-	dNames := descDayNames
-	mNames := descMonthNames
-	if opts.Short {
-		dNames = descDayShortNames
-		mNames = descMonthShortNames
+// describer renders a descSchedule with the options of one Describe call.
+type describer struct {
+	t           Translator
+	short       bool
+	src, target *time.Location
+	style       TimeStyle
+	layout      string
+	second      int       // the second shown in times when style.Seconds is set
+	ref         time.Time // the reference time in the source location
+	literal     bool      // whether idioms are off
+	converted   bool      // whether a time was converted to the target location
+}
+
+func newDescriber(opts *DescribeOptions) *describer {
+	d := &describer{
+		t:       opts.Translator,
+		short:   opts.Short,
+		src:     opts.SourceLocation,
+		target:  opts.TargetLocation,
+		style:   TimeStyle{Short: opts.Short, Hour24: opts.Hour24, Seconds: opts.Seconds},
+		layout:  opts.TimeFormat,
+		literal: opts.Literal,
+	}
+	if d.t == nil {
+		d.t, _ = LookupLocale(opts.Locale)
+	}
+	if len(opts.Phrases) > 0 {
+		d.t = phrasebook{d.t, opts.Phrases}
+	}
+	if d.src == nil {
+		d.src = time.UTC
+	}
+	if d.target == nil {
+		d.target = time.UTC
 	}
+	d.ref = opts.ReferenceTime
+	if d.ref.IsZero() {
+		d.ref = time.Now()
+	}
+	d.ref = d.ref.In(d.src)
+	return d
+}
 
-	var parts []string
+// phrase returns the translator's message key in its form for n, with {n}
+// replaced by n and {1}, {2}, ... by args.
+func (d *describer) phrase(key string, n int, args ...Description) Description {
+	return fill(d.t.Message(key, n, d.short), valuePart(NumberPart, NoField, strconv.Itoa(n), n)[0], args)
+}
 
-	timeDesc, dayOffset := describeTime(fields, srcLoc, targetLoc, opts.Short)
-	if timeDesc != "" {
-		parts = append(parts, timeDesc)
-	}
+// every is phrase for messages whose {n} is a step of field.
+func (d *describer) every(key string, field Field, n int, args ...Description) Description {
+	return fill(d.t.Message(key, n, d.short), valuePart(IntervalPart, field, strconv.Itoa(n), n)[0], args)
+}
 
-	dateDesc := describeDate(fields, dayOffset, dNames, mNames, opts.Short)
-	if dateDesc != "" {
-		parts = append(parts, dateDesc)
+// join joins items with the translator's list separators.
+func (d *describer) join(items []Description) Description {
+	placeholders := make([]string, len(items))
+	for i := range items {
+		placeholders[i] = "{" + strconv.Itoa(i+1) + "}"
 	}
+	return fill(d.t.Join(placeholders), textPart(""), items)
+}
 
-	if len(parts) == 0 {
-		return "Every minute"
-	}
+// dayName returns the translated name of day shifted by offset days.
+func (d *describer) dayName(day, offset int, short bool) Description {
+	return valuePart(DayPart, DayOfWeekField, d.t.DayName(time.Weekday(descAdjustDay(day, offset)), short), day)
+}
 
-	return strings.Join(parts, ", ")
+// monthName returns the translated name of month m.
+func (d *describer) monthName(m int) Description {
+	return valuePart(MonthPart, MonthField, d.t.MonthName(time.Month(m), d.short), m)
 }
 
-// descFields holds the raw field strings from the normalized cron expression.
-type descFields struct {
-	seconds    string
-	minutes    string
-	hours      string
-	dayOfMonth string
-	month      string
-	dayOfWeek  string
+// ordinal returns day of the month as an ordinal.
+func (d *describer) ordinal(day int) Description {
+	return valuePart(OrdinalPart, DayOfMonthField, d.t.Ordinal(day), day)
 }
 
-// descParseFields splits the normalized cron string into fields.
-// Returns nil if the field count is unexpected.
-func descParseFields(normalized string) *descFields {
-	parts := strings.Fields(normalized)
+// descCapitalize upper-cases the first letter of a description.
+func descCapitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
 
-	var f descFields
-	switch len(parts) {
-	case 5: // minute hour dom month dow
-		f.seconds = "0"
-		f.minutes = parts[0]
-		f.hours = parts[1]
-		f.dayOfMonth = parts[2]
-		f.month = parts[3]
-		f.dayOfWeek = parts[4]
-	case 6: // second minute hour dom month dow
-		f.seconds = parts[0]
-		f.minutes = parts[1]
-		f.hours = parts[2]
-		f.dayOfMonth = parts[3]
-		f.month = parts[4]
-		f.dayOfWeek = parts[5]
-	case 7: // second minute hour dom month dow year
-		f.seconds = parts[0]
-		f.minutes = parts[1]
-		f.hours = parts[2]
-		f.dayOfMonth = parts[3]
-		f.month = parts[4]
-		f.dayOfWeek = parts[5]
-		// year ignored for description
-	default:
-		return nil
+// descSchedule is the model Describe renders: the parsed fields of an
+// Expression, with the day fields split into their plain values and their
+// L, W and # entries.
+type descSchedule struct {
+	seconds, minutes, hours, months, years descField
+
+	daysOfMonth   descField // plain days; empty when only L or W entries are given
+	workdays      []int     // 15W
+	lastDay       bool      // L
+	lastWorkday   bool      // LW
+	domRestricted bool
+
+	daysOfWeek    descField // plain days
+	nthWeekdays   []descNth // 1#2
+	lastWeekdays  []int     // 5L
+	dowRestricted bool
+
+	businessHours bool // hours and days of the week described by the idiom
+}
+
+// descNth is a day-of-week # entry: the week-th day of the month.
+type descNth struct {
+	week, day int
+}
+
+// newDescSchedule builds the model of a parsed cron expression.
+func newDescSchedule(expr *Expression) descSchedule {
+	s := descSchedule{
+		seconds:       descField{expr.secondList, 0, 59, false},
+		minutes:       descField{expr.minuteList, 0, 59, false},
+		hours:         descField{expr.hourList, 0, 23, true},
+		months:        descField{expr.monthList, 1, 12, true},
+		years:         descField{expr.yearList, minYear, maxYear, false},
+		daysOfMonth:   descField{toList(expr.daysOfMonth), 1, 31, false},
+		workdays:      toList(expr.workdaysOfMonth),
+		lastDay:       expr.lastDayOfMonth,
+		lastWorkday:   expr.lastWorkdayOfMonth,
+		domRestricted: expr.daysOfMonthRestricted,
+		daysOfWeek:    descField{toList(expr.daysOfWeek), 0, 6, true},
+		lastWeekdays:  toList(expr.lastWeekDaysOfWeek),
+		dowRestricted: expr.daysOfWeekRestricted,
+	}
+	for _, k := range toList(expr.specificWeekDaysOfWeek) {
+		s.nthWeekdays = append(s.nthWeekdays, descNth{week: k/daysPerWeek + 1, day: k % daysPerWeek})
+	}
+	// Next runs on the union of the day fields, so one listing every day,
+	// such as */1, runs every day.
+	if s.domRestricted && s.daysOfMonth.all() || s.dowRestricted && s.daysOfWeek.all() {
+		s.domRestricted, s.dowRestricted = false, false
 	}
+	return s
+}
 
-	// Normalize ? to *
-	f.dayOfMonth = strings.ReplaceAll(f.dayOfMonth, "?", "*")
-	f.dayOfWeek = descNormalizeDow(f.dayOfWeek)
-	f.month = descNormalizeMonth(f.month)
-	f.minutes = strings.ReplaceAll(f.minutes, "?", "*")
-	f.hours = strings.ReplaceAll(f.hours, "?", "*")
+// descField is the sorted values of one field with the field's range. Its
+// methods recognize the shapes Describe names: every value, a step from the
+// start of the field, an arithmetic progression, and runs of consecutive
+// values, which wrap around the end of a cyclic field such as hours 22-3 or
+// days FRI-MON.
+type descField struct {
+	values []int
+	lo, hi int
+	cyclic bool
+}
 
-	return &f
+// descSpan is a run of consecutive values from first to last, or a single
+// value when first == last. A span of a cyclic field wraps when first > last.
+type descSpan struct {
+	first, last int
 }
 
-var descDayMap = map[string]string{
-	"sun": "0", "mon": "1", "tue": "2", "wed": "3",
-	"thu": "4", "fri": "5", "sat": "6",
+// all reports whether the field holds every value in its range.
+func (f descField) all() bool {
+	n := len(f.values)
+	return n == f.hi-f.lo+1 && f.values[0] == f.lo && f.values[n-1] == f.hi
 }
-var descMonthMap = map[string]string{
-	"jan": "1", "feb": "2", "mar": "3", "apr": "4",
-	"may": "5", "jun": "6", "jul": "7", "aug": "8",
-	"sep": "9", "oct": "10", "nov": "11", "dec": "12",
+
+// single returns the only value of the field.
+func (f descField) single() (int, bool) {
+	if len(f.values) != 1 {
+		return 0, false
+	}
+	return f.values[0], true
+}
+
+// step returns n when the values are every n-th one from the start of the
+// field through its end, as */n gives.
+func (f descField) step() (int, bool) {
+	first, n, ok := f.stepFrom()
+	return n, ok && first == f.lo
 }
 
-func descNormalizeDow(s string) string {
-	s = strings.ToLower(s)
-	s = strings.ReplaceAll(s, "?", "*")
-	for name, num := range descDayMap {
-		s = strings.ReplaceAll(s, name, num)
+// stepFrom returns the first value and n when the values are every n-th one
+// from the first through the end of the field, as first/n gives.
+func (f descField) stepFrom() (first, n int, ok bool) {
+	if len(f.values) < 2 {
+		return 0, 0, false
 	}
-	if s == "7" {
-		s = "0"
+	n = f.values[1] - f.values[0]
+	for i := 2; i < len(f.values); i++ {
+		if f.values[i]-f.values[i-1] != n {
+			return 0, 0, false
+		}
 	}
-	return s
+	if f.values[len(f.values)-1]+n <= f.hi {
+		return 0, 0, false
+	}
+	return f.values[0], n, true
 }
 
-func descNormalizeMonth(s string) string {
-	s = strings.ToLower(s)
-	for name, num := range descMonthMap {
-		s = strings.ReplaceAll(s, name, num)
+// progression returns the first and last value and n when the values are
+// three or more, every n-th one for n > 1, as 5-20/3 gives.
+func (f descField) progression() (first, last, n int, ok bool) {
+	if len(f.values) < 3 {
+		return 0, 0, 0, false
+	}
+	n = f.values[1] - f.values[0]
+	for i := 2; i < len(f.values); i++ {
+		if f.values[i]-f.values[i-1] != n {
+			return 0, 0, 0, false
+		}
 	}
-	return strings.ReplaceAll(s, "?", "*")
+	return f.values[0], f.values[len(f.values)-1], n, n > 1
 }
 
-// describeTime returns the time description and a day offset (-1, 0, or 1) for TZ conversion.
-func describeTime(f *descFields, srcLoc, targetLoc *time.Location, short bool) (string, int) {
-	// Intervals are timezone-agnostic
-	if f.hours == "*" && f.minutes != "*" {
-		if interval, ok := descParseInterval(f.minutes); ok {
-			if interval == 1 {
-				if short {
-					return "Every min", 0
-				}
-				return "Every minute", 0
-			}
-			if short {
-				return fmt.Sprintf("Every %d mins", interval), 0
-			}
-			return fmt.Sprintf("Every %d minutes", interval), 0
+// run returns the first and last value when the values are one span of
+// three or more, as a range gives. Two values read better as a list.
+func (f descField) run() (first, last int, ok bool) {
+	spans := f.spans()
+	if len(spans) != 1 || spans[0].first == spans[0].last {
+		return 0, 0, false
+	}
+	return spans[0].first, spans[0].last, true
+}
+
+// spans groups the values into runs of three or more consecutive values and
+// single values, joining a run through the end of a cyclic field with one
+// from its start.
+func (f descField) spans() []descSpan {
+	var runs []descSpan
+	for _, v := range f.values {
+		if n := len(runs); n > 0 && runs[n-1].last == v-1 {
+			runs[n-1].last = v
+		} else {
+			runs = append(runs, descSpan{v, v})
+		}
+	}
+	if n := len(runs); f.cyclic && n > 1 && runs[0].first == f.lo && runs[n-1].last == f.hi {
+		if wrapped := (descSpan{runs[n-1].first, runs[0].last}); f.length(wrapped) >= 3 {
+			runs = append(runs[1:n-1:n-1], wrapped)
 		}
-		// Specific minute, every hour (e.g. @hourly = "0 * * * *")
-		minDesc := describeMinutes(f.minutes, short)
-		return minDesc + ", every hour", 0
 	}
 
-	if f.minutes == "*" && f.hours != "*" {
-		if interval, ok := descParseInterval(f.hours); ok {
-			everyMin := "Every minute"
-			if short {
-				everyMin = "Every min"
-			}
-			if interval == 1 {
-				return everyMin + ", every hour", 0
-			}
-			return fmt.Sprintf("%s, every %d hours", everyMin, interval), 0
+	spans := make([]descSpan, 0, len(runs))
+	for _, r := range runs {
+		if f.length(r) == 2 {
+			spans = append(spans, descSpan{r.first, r.first}, descSpan{r.last, r.last})
+		} else {
+			spans = append(spans, r)
 		}
 	}
+	return spans
+}
 
-	if f.hours == "*" && f.minutes == "*" {
-		return "Every minute", 0
+// length returns the number of values in a span of the field.
+func (f descField) length(s descSpan) int {
+	if s.first <= s.last {
+		return s.last - s.first + 1
 	}
+	return f.hi - s.first + 1 + s.last - f.lo + 1
+}
 
-	if interval, ok := descParseInterval(f.hours); ok {
-		minDesc := describeMinutes(f.minutes, short)
-		if interval == 1 {
-			return minDesc + ", every hour", 0
+// spanItems formats the spans of a field as list items, a run of values as
+// a span phrase ("15th–20th").
+func (d *describer) spanItems(f descField, format func(int) Description) []Description {
+	var items []Description
+	for _, s := range f.spans() {
+		if s.first == s.last {
+			items = append(items, format(s.first))
+		} else {
+			items = append(items, d.phrase("span", 0, format(s.first), format(s.last)))
 		}
-		return fmt.Sprintf("%s, every %d hours", minDesc, interval), 0
+	}
+	return items
+}
+
+// describeTime returns the time description and a day offset (-1, 0, or 1) for TZ conversion.
+func (d *describer) describeTime(s *descSchedule) (Description, int) {
+	minute, singleMinute := s.minutes.single()
+	_, _, hourRun := s.hours.run()
+	_, hourStep := s.hours.step()
+	// Twice daily is every 12 hours, such as 9:00 AM and 9:00 PM.
+	twiceDaily := singleMinute && len(s.hours.values) == 2 && s.hours.values[1]-s.hours.values[0] == 12 &&
+		!s.domRestricted && !s.dowRestricted && d.hasIdiom("idiom-twice-daily")
+	specific := twiceDaily || singleMinute && !s.hours.all() && !hourRun && !hourStep
+
+	// A single second joins specific times (9:00:30 AM); other seconds than
+	// the default :00 are described on their own.
+	var secDesc Description
+	if sec, ok := s.seconds.single(); ok && specific {
+		d.style.Seconds = d.style.Seconds || sec != 0
+	} else if !ok || sec != 0 {
+		secDesc = d.describeSeconds(s.seconds)
 	}
 
 	// Specific times — need timezone conversion
-	if descIsList(f.hours) {
-		hours := descSplitList(f.hours)
-		var times []string
+	if specific {
+		var times []Description
 		var dayOffset int
-		for _, h := range hours {
-			t, offset := descFormatTimeWithTZ(h, f.minutes, srcLoc, targetLoc, short)
+		for _, h := range s.hours.values {
+			t, offset := d.formatTime(h, minute, true)
 			times = append(times, t)
 			dayOffset = offset
 		}
-		return "At " + descJoinWithAnd(times), dayOffset
+		if twiceDaily {
+			return d.withSeconds(secDesc, d.idiom("idiom-twice-daily", d.join(times))), dayOffset
+		}
+		return d.withSeconds(secDesc, d.phrase("at", len(times), d.join(times))), dayOffset
 	}
 
-	if descIsRange(f.hours) {
-		start, end := descParseRange(f.hours)
-		minDesc := describeMinutes(f.minutes, short)
-		startFmt, dayOffset := descFormatHourWithTZ(start, srcLoc, targetLoc, short)
-		endFmt, _ := descFormatHourWithTZ(end, srcLoc, targetLoc, short)
-		return fmt.Sprintf("%s, %s–%s", minDesc, startFmt, endFmt), dayOffset
+	minDesc := d.describeMinutes(s.minutes)
+	if secDesc != nil && s.minutes.all() {
+		// Seconds of every minute stand in for the minutes.
+		minDesc, secDesc = secDesc, nil
+		if _, ok := s.seconds.step(); !ok && s.hours.all() {
+			return d.phrase("seconds-of-minute", 0, minDesc), 0
+		}
 	}
-
-	t, dayOffset := descFormatTimeWithTZ(f.hours, f.minutes, srcLoc, targetLoc, short)
-	return "At " + t, dayOffset
+	desc, dayOffset := d.describeHours(s, minDesc)
+	return d.withSeconds(secDesc, desc), dayOffset
 }
 
-func describeMinutes(minutes string, short bool) string {
-	if minutes == "*" || minutes == "0" {
-		if short {
-			return "At min 0"
+// describeHours combines the description of the minutes with the hours.
+func (d *describer) describeHours(s *descSchedule, minDesc Description) (Description, int) {
+	if s.hours.all() {
+		if _, ok := s.minutes.step(); ok || s.minutes.all() {
+			return minDesc, 0
 		}
-		return "At minute 0"
+		return d.every("hourly", HourField, 1, minDesc), 0
 	}
-	if interval, ok := descParseInterval(minutes); ok {
-		if interval == 1 {
-			if short {
-				return "Every min"
-			}
-			return "Every minute"
-		}
-		if short {
-			return fmt.Sprintf("Every %d mins", interval)
-		}
-		return fmt.Sprintf("Every %d minutes", interval)
-	}
-	m, _ := strconv.Atoi(minutes)
-	if short {
-		return fmt.Sprintf("At min %d", m)
-	}
-	return fmt.Sprintf("At minute %d", m)
-}
 
-// describeDate generates date/day description, adjusting DOW by dayOffset for TZ conversion.
-func describeDate(f *descFields, dayOffset int, dNames, mNames []string, short bool) string {
-	var parts []string
+	// Intervals are timezone-agnostic
+	if interval, ok := s.hours.step(); ok {
+		return d.every("hourly", HourField, interval, minDesc), 0
+	}
 
-	dowDesc := describeDayOfWeek(f.dayOfWeek, dayOffset, dNames)
-	domDesc := describeDayOfMonth(f.dayOfMonth, short)
-	monthDesc := describeMonth(f.month, mNames)
+	if s.businessHours {
+		return d.idiom("idiom-business-hours", minDesc), 0
+	}
 
-	switch {
-	case domDesc != "" && dowDesc != "":
-		if monthDesc != "" {
-			parts = append(parts, domDesc, "and "+dowDesc, monthDesc)
-		} else {
-			parts = append(parts, domDesc, "and "+dowDesc)
-		}
-	case domDesc != "":
-		if monthDesc != "" {
-			parts = append(parts, domDesc, monthDesc)
-		} else {
-			parts = append(parts, domDesc)
-		}
-	case dowDesc != "":
-		parts = append(parts, dowDesc)
-		if monthDesc != "" {
-			parts = append(parts, monthDesc)
-		}
-	case monthDesc != "":
-		parts = append(parts, monthDesc)
+	if start, end, ok := s.hours.run(); ok {
+		startFmt, dayOffset := d.formatTime(start, 0, false)
+		endFmt, _ := d.formatTime(end, 0, false)
+		return d.phrase("hour-range", 0, minDesc, startFmt, endFmt), dayOffset
 	}
 
-	if len(parts) == 0 {
-		return ""
+	if start, end, interval, ok := s.hours.progression(); ok {
+		startFmt, dayOffset := d.formatTime(start, 0, false)
+		endFmt, _ := d.formatTime(end, 0, false)
+		return d.every("hours-between", HourField, interval, minDesc, startFmt, endFmt), dayOffset
 	}
 
-	return strings.Join(parts, " ")
+	// Several minutes within listed hours
+	var dayOffset int
+	hours := d.spanItems(s.hours, func(h int) Description {
+		t, offset := d.formatTime(h, 0, false)
+		dayOffset = offset
+		return t
+	})
+	return d.phrase("during-hours", len(s.hours.values), minDesc, d.join(hours)), dayOffset
 }
 
-func describeDayOfWeek(dow string, dayOffset int, names []string) string {
-	if dow == "*" {
-		return ""
+// withSeconds prefixes a time description with that of the seconds, if any.
+func (d *describer) withSeconds(secDesc, desc Description) Description {
+	if secDesc == nil {
+		return desc
 	}
+	return d.phrase("with-seconds", 0, secDesc, desc)
+}
 
-	// Last DOW pattern (e.g., 5L = last Friday)
-	if strings.HasSuffix(dow, "l") || strings.HasSuffix(dow, "L") {
-		day := strings.TrimSuffix(strings.TrimSuffix(dow, "l"), "L")
-		d, _ := strconv.Atoi(day)
-		if d >= 0 && d <= 6 {
-			d = descAdjustDay(d, dayOffset)
-			return fmt.Sprintf("on the last %s of the month", names[d])
-		}
-	}
+func (d *describer) describeSeconds(f descField) Description {
+	return d.describeCount(f, "seconds", SecondField)
+}
 
-	// Nth DOW pattern (e.g., 1#2 = second Monday)
-	if strings.Contains(dow, "#") {
-		parts := strings.Split(dow, "#")
-		if len(parts) == 2 {
-			day, _ := strconv.Atoi(parts[0])
-			nth, _ := strconv.Atoi(parts[1])
-			if day >= 0 && day <= 6 && nth >= 1 && nth <= 5 {
-				day = descAdjustDay(day, dayOffset)
-				ordinal := []string{"", "first", "second", "third", "fourth", "fifth"}[nth]
-				return fmt.Sprintf("on the %s %s of the month", ordinal, names[day])
-			}
-		}
-	}
+func (d *describer) describeMinutes(f descField) Description {
+	return d.describeCount(f, "minutes", MinuteField)
+}
 
-	if descIsRange(dow) {
-		start, end := descParseRange(dow)
-		startDay, _ := strconv.Atoi(start)
-		endDay, _ := strconv.Atoi(end)
-		if startDay >= 0 && startDay <= 6 && endDay >= 0 && endDay <= 6 {
-			startDay = descAdjustDay(startDay, dayOffset)
-			endDay = descAdjustDay(endDay, dayOffset)
-			return fmt.Sprintf("%s–%s", names[startDay], names[endDay])
-		}
+// describeCount describes the seconds or minutes of f with the messages
+// every-<unit>, every-<unit>-between and at-<unit>.
+func (d *describer) describeCount(f descField, unit string, field Field) Description {
+	number := numberPart(field)
+	if f.all() {
+		return d.every("every-"+unit, field, 1)
 	}
-
-	if descIsList(dow) {
-		days := descSplitList(dow)
-		var dayNamesList []string
-		for _, d := range days {
-			if n, err := strconv.Atoi(d); err == nil && n >= 0 && n <= 6 {
-				n = descAdjustDay(n, dayOffset)
-				dayNamesList = append(dayNamesList, names[n])
-			}
-		}
-		return descJoinWithAnd(dayNamesList) + " only"
+	if interval, ok := f.step(); ok {
+		return d.every("every-"+unit, field, interval)
+	}
+	if first, last, interval, ok := f.progression(); ok {
+		return d.every("every-"+unit+"-between", field, interval, number(first), number(last))
 	}
+	return d.phrase("at-"+unit, len(f.values), d.join(d.spanItems(f, number)))
+}
 
-	d, err := strconv.Atoi(dow)
-	if err == nil && d >= 0 && d <= 6 {
-		d = descAdjustDay(d, dayOffset)
-		return descDayNames[d] + " only"
+// describeDate generates date/day description, adjusting DOW by dayOffset for TZ conversion.
+func (d *describer) describeDate(s *descSchedule, dayOffset int) Description {
+	dowDesc := d.describeDayOfWeek(s, dayOffset)
+	domDesc := d.describeDayOfMonth(s)
+	// Months after days of the week drop the "only" of "only in January".
+	monthKey := "months"
+	if dowDesc != nil || s.businessHours {
+		monthKey = "weekday-months"
+	}
+	if idiom := d.monthIdiom(s, domDesc); idiom != nil && dowDesc == nil {
+		return idiom
 	}
+	monthDesc := d.describeMonth(s.months, monthKey)
 
-	return ""
+	days := domDesc
+	switch {
+	case domDesc != nil && dowDesc != nil:
+		days = d.phrase("days-union", 0, domDesc, dowDesc)
+	case dowDesc != nil:
+		days = dowDesc
+	}
+	switch {
+	case days == nil:
+		return monthDesc
+	case monthDesc == nil:
+		return days
+	default:
+		return d.phrase("date", 0, days, monthDesc)
+	}
 }
 
-func describeDayOfMonth(dom string, short bool) string {
-	if dom == "*" {
-		return ""
+func (d *describer) describeDayOfWeek(s *descSchedule, dayOffset int) Description {
+	if !s.dowRestricted || s.businessHours {
+		return nil
 	}
 
-	if strings.ToLower(dom) == "l" {
-		if short {
-			return "last day of month"
-		}
-		return "on the last day of the month"
+	// Plain days: an idiom (weekdays), a range (Monday–Friday) or a list
+	// (Monday and Friday only)
+	var plain Description
+	if idiom := d.weekdayIdiom(s.daysOfWeek, dayOffset); idiom != nil {
+		plain = idiom
+	} else if start, end, ok := s.daysOfWeek.run(); ok {
+		plain = d.phrase("weekday-range", 0, d.dayName(start, dayOffset, d.short), d.dayName(end, dayOffset, d.short))
+	} else if day, ok := s.daysOfWeek.single(); ok {
+		plain = d.phrase("weekdays", 1, d.dayName(day, dayOffset, false))
+	} else if len(s.daysOfWeek.values) > 0 {
+		names := d.spanItems(s.daysOfWeek, func(day int) Description {
+			return d.dayName(day, dayOffset, d.short)
+		})
+		plain = d.phrase("weekdays", len(s.daysOfWeek.values), d.join(names))
 	}
 
-	if strings.HasSuffix(strings.ToUpper(dom), "W") {
-		day := strings.TrimSuffix(strings.TrimSuffix(dom, "w"), "W")
-		d, _ := strconv.Atoi(day)
-		if short {
-			return fmt.Sprintf("weekday nearest the %s", descOrdinal(d))
-		}
-		return fmt.Sprintf("on the weekday nearest the %s of the month", descOrdinal(d))
+	// Nth (1#2 = second Monday) and last (5L = last Friday) days of the month
+	var monthly []Description
+	for _, n := range s.nthWeekdays {
+		week := valuePart(OrdinalPart, DayOfWeekField, d.t.WeekOrdinal(n.week), n.week)
+		monthly = append(monthly, d.phrase("nth-weekday", n.week, week, d.dayName(n.day, dayOffset, d.short)))
+	}
+	for _, day := range s.lastWeekdays {
+		monthly = append(monthly, d.phrase("last-weekday", 0, d.dayName(day, dayOffset, d.short)))
 	}
 
-	if descIsRange(dom) {
-		start, end := descParseRange(dom)
-		startN, _ := strconv.Atoi(start)
-		endN, _ := strconv.Atoi(end)
-		if short {
-			return fmt.Sprintf("days %s–%s", start, descOrdinal(endN))
-		}
-		return fmt.Sprintf("on the %s–%s of the month", descOrdinal(startN), descOrdinal(endN))
+	switch {
+	case len(monthly) == 0:
+		return plain
+	case plain == nil:
+		return d.phrase("monthly-weekdays", len(monthly), d.join(monthly))
+	default:
+		return d.phrase("weekdays-and-monthly", len(monthly), plain, d.join(monthly))
 	}
+}
 
-	if descIsList(dom) {
-		days := descSplitList(dom)
-		var ordinals []string
-		for _, d := range days {
-			n, _ := strconv.Atoi(d)
-			ordinals = append(ordinals, descOrdinal(n))
-		}
-		if short {
-			return "on the " + descJoinWithAnd(ordinals)
-		}
-		return "on the " + descJoinWithAnd(ordinals) + " of the month"
+// weekdayIdiom returns the idiom for plain days of the week shifted by
+// dayOffset, such as weekdays for Monday–Friday, or nil if there is none.
+func (d *describer) weekdayIdiom(f descField, dayOffset int) Description {
+	switch descDaySet(f, dayOffset) {
+	case descWorkWeek:
+		return d.idiom("idiom-weekdays")
+	case descWeekend:
+		return d.idiom("idiom-weekends")
 	}
+	return nil
+}
 
-	if interval, ok := descParseInterval(dom); ok {
-		if interval == 1 {
-			return "every day"
-		}
-		return fmt.Sprintf("every %d days", interval)
+var (
+	descWorkWeek = [7]bool{false, true, true, true, true, true, false}
+	descWeekend  = [7]bool{true, false, false, false, false, false, true}
+)
+
+// descDaySet returns the days of the week of f shifted by offset days.
+func descDaySet(f descField, offset int) [7]bool {
+	var days [7]bool
+	for _, day := range f.values {
+		days[descAdjustDay(day, offset)] = true
 	}
+	return days
+}
 
-	d, _ := strconv.Atoi(dom)
-	if short {
-		return fmt.Sprintf("on the %s", descOrdinal(d))
+// hasIdiom reports whether idioms are on and the translator has the idiom
+// key.
+func (d *describer) hasIdiom(key string) bool {
+	return !d.literal && d.t.Message(key, 0, d.short) != ""
+}
+
+// idiom returns the idiom key with args, or nil if idioms are off or the
+// translator has none.
+func (d *describer) idiom(key string, args ...Description) Description {
+	if !d.hasIdiom(key) {
+		return nil
 	}
-	return fmt.Sprintf("on the %s of the month", descOrdinal(d))
+	return d.phrase(key, 0, args...)
 }
 
-// descOrdinal returns an integer with its English ordinal suffix (1st, 2nd, 3rd, etc.).
-func descOrdinal(n int) string {
-	if n%100 >= 11 && n%100 <= 13 {
-		return strconv.Itoa(n) + "th"
+// businessHours reports whether a schedule runs hourly from 9:00 through
+// 17:00 Monday–Friday in the target location and on no other days.
+func (d *describer) businessHours(s *descSchedule) bool {
+	start, end, ok := s.hours.run()
+	if !ok || !s.dowRestricted || s.domRestricted || len(s.nthWeekdays)+len(s.lastWeekdays) > 0 {
+		return false
 	}
-	switch n % 10 {
-	case 1:
-		return strconv.Itoa(n) + "st"
-	case 2:
-		return strconv.Itoa(n) + "nd"
-	case 3:
-		return strconv.Itoa(n) + "rd"
-	default:
-		return strconv.Itoa(n) + "th"
+	startTime, offset := d.convert(start, 0, 0)
+	endTime, endOffset := d.convert(end, 0, 0)
+	if startTime.Hour() != 9 || endTime.Hour() != 17 || offset != endOffset {
+		return false
 	}
+	return descDaySet(s.daysOfWeek, offset) == descWorkWeek && d.hasIdiom("idiom-business-hours")
 }
 
-func describeMonth(month string, names []string) string {
-	if month == "*" {
-		return ""
+// monthIdiom returns the idiom for a single day of the month in the first
+// month of each quarter or half year, such as "quarterly on the 1st of the
+// month", or nil if there is none.
+func (d *describer) monthIdiom(s *descSchedule, domDesc Description) Description {
+	if !s.domRestricted || s.dowRestricted ||
+		len(s.daysOfMonth.values)+len(s.workdays)+btoi(s.lastDay)+btoi(s.lastWorkday) != 1 {
+		return nil
+	}
+	switch {
+	case slices.Equal(s.months.values, []int{1, 4, 7, 10}):
+		return d.idiom("idiom-quarterly", domDesc, d.dayItems(s)[0])
+	case slices.Equal(s.months.values, []int{1, 7}):
+		return d.idiom("idiom-semiannually", domDesc, d.dayItems(s)[0])
 	}
+	return nil
+}
 
-	if descIsRange(month) {
-		start, end := descParseRange(month)
-		startMonth, _ := strconv.Atoi(start)
-		endMonth, _ := strconv.Atoi(end)
-		if startMonth >= 1 && startMonth <= 12 && endMonth >= 1 && endMonth <= 12 {
-			return fmt.Sprintf("%s–%s", names[startMonth], names[endMonth])
-		}
+func (d *describer) describeDayOfMonth(s *descSchedule) Description {
+	if !s.domRestricted {
+		return nil
 	}
+	days := s.daysOfMonth
+	special := len(s.workdays) > 0 || s.lastDay || s.lastWorkday
+	number := numberPart(DayOfMonthField)
 
-	if descIsList(month) {
-		months := descSplitList(month)
-		var monthNamesList []string
-		for _, m := range months {
-			if n, err := strconv.Atoi(m); err == nil && n >= 1 && n <= 12 {
-				monthNamesList = append(monthNamesList, names[n])
+	if !special {
+		if interval, ok := days.step(); ok {
+			if idiom := d.idiom("idiom-every-other-day"); idiom != nil && interval == 2 {
+				return idiom
 			}
+			return d.every("every-days", DayOfMonthField, interval)
+		}
+		if start, end, ok := days.run(); ok {
+			return d.phrase("day-range", 0, d.ordinal(start), d.ordinal(end), number(start), number(end))
+		}
+		if start, end, interval, ok := days.progression(); ok {
+			return d.every("every-days-between", DayOfMonthField, interval, d.ordinal(start), d.ordinal(end))
 		}
-		return "in " + descJoinWithAnd(monthNamesList)
 	}
 
-	m, err := strconv.Atoi(month)
-	if err == nil && m >= 1 && m <= 12 {
-		return "only in " + names[m]
+	if len(days.values) == 0 && len(s.workdays)+btoi(s.lastDay)+btoi(s.lastWorkday) == 1 {
+		switch {
+		case s.lastDay:
+			return d.phrase("last-day", 0)
+		case s.lastWorkday:
+			if idiom := d.idiom("idiom-last-business-day"); idiom != nil {
+				return idiom
+			}
+			return d.phrase("last-workday", 0)
+		case s.workdays[0] == 1:
+			// The weekday nearest the 1st stays in the month.
+			if idiom := d.idiom("idiom-first-business-day"); idiom != nil {
+				return idiom
+			}
+		}
+		return d.phrase("nearest-workday", 0, d.ordinal(s.workdays[0]))
 	}
 
-	return ""
+	ordinals := d.dayItems(s)
+	return d.phrase("days", len(ordinals), d.join(ordinals))
 }
 
-// Helpers
-
-var descIntervalRe = regexp.MustCompile(`^\*/(\d+)$`)
-
-func descParseInterval(s string) (int, bool) {
-	matches := descIntervalRe.FindStringSubmatch(s)
-	if len(matches) == 2 {
-		n, _ := strconv.Atoi(matches[1])
-		return n, true
+// dayItems returns the days of the month of s as list items, such as "15th"
+// or "last day".
+func (d *describer) dayItems(s *descSchedule) []Description {
+	items := d.spanItems(s.daysOfMonth, d.ordinal)
+	for _, day := range s.workdays {
+		items = append(items, d.phrase("day-nearest-workday", 0, d.ordinal(day)))
+	}
+	if s.lastWorkday {
+		items = append(items, d.phrase("day-last-workday", 0))
+	}
+	if s.lastDay {
+		items = append(items, d.phrase("day-last", 0))
 	}
-	return 0, false
+	return items
 }
 
-func descIsRange(s string) bool {
-	return strings.Contains(s, "-") && !strings.HasPrefix(s, "-")
+// btoi returns 1 for true and 0 for false.
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
 }
 
-func descParseRange(s string) (string, string) {
-	if before, after, ok := strings.Cut(s, "-"); ok {
-		return before, after
+func (d *describer) describeMonth(f descField, key string) Description {
+	if f.all() {
+		return nil
 	}
-	return s, s
-}
 
-func descIsList(s string) bool {
-	return strings.Contains(s, ",")
-}
+	if start, end, ok := f.run(); ok {
+		return d.phrase("month-range", 0, d.monthName(start), d.monthName(end))
+	}
 
-func descSplitList(s string) []string {
-	return strings.Split(s, ",")
+	return d.phrase(key, len(f.values), d.join(d.spanItems(f, d.monthName)))
 }
 
-func descJoinWithAnd(items []string) string {
-	switch len(items) {
-	case 0:
-		return ""
-	case 1:
-		return items[0]
-	case 2:
-		return items[0] + " and " + items[1]
-	default:
-		return strings.Join(items[:len(items)-1], ", ") + ", and " + items[len(items)-1]
+func (d *describer) describeYears(f descField) Description {
+	if f.all() {
+		return nil
 	}
+	year := func(y int) Description {
+		return valuePart(YearPart, YearField, strconv.Itoa(y), y)
+	}
+	if start, end, ok := f.run(); ok {
+		return d.phrase("year-range", 0, year(start), year(end))
+	}
+	if start, n, ok := f.stepFrom(); ok && len(f.values) > 2 {
+		return d.every("every-years", YearField, n, year(start))
+	}
+	return d.phrase("years", len(f.values), d.join(d.spanItems(f, year)))
 }
 
+// Helpers
+
 // descAdjustDay shifts a day-of-week (0-6) by offset, wrapping around.
 func descAdjustDay(day, offset int) int {
 	day = (day + offset) % 7
@@ -513,18 +739,35 @@ func descAdjustDay(day, offset int) int {
 	return day
 }
 
-// descFormatTimeWithTZ converts hour:minute from srcLoc to targetLoc in 12-hour format.
-// Returns formatted time and day offset (-1, 0, or 1) if conversion crossed a day boundary.
-func descFormatTimeWithTZ(hour, minute string, srcLoc, targetLoc *time.Location, short bool) (string, int) {
-	h, err := strconv.Atoi(hour)
-	if err != nil {
-		return hour + ":" + minute, 0
-	}
-	m, _ := strconv.Atoi(minute)
+// formatTime converts hour:minute from the source to the target location
+// and formats it with the layout or translator, with the second if seconds is
+// set and the style shows them. It also returns the day offset (-1, 0, or 1)
+// if conversion crossed a day boundary.
+func (d *describer) formatTime(h, m int, seconds bool) (Description, int) {
+	style := d.style
+	style.Seconds = style.Seconds && seconds
+	sec := 0
+	if style.Seconds {
+		sec = d.second
+	}
+
+	targetTime, dayOffset := d.convert(h, m, sec)
+	d.converted = true
+	var text string
+	if d.layout != "" {
+		text = targetTime.Format(d.layout)
+	} else {
+		text = d.t.Time(targetTime.Hour(), targetTime.Minute(), targetTime.Second(), style)
+	}
+	return valuePart(TimePart, HourField, text, h, m, sec), dayOffset
+}
 
-	now := time.Now()
-	srcTime := time.Date(now.Year(), now.Month(), now.Day(), h, m, 0, 0, srcLoc)
-	targetTime := srcTime.In(targetLoc)
+// convert returns the source time of day h:m:sec on the reference date in
+// the target location, and the day offset (-1, 0, or 1) if conversion
+// crossed a day boundary.
+func (d *describer) convert(h, m, sec int) (time.Time, int) {
+	srcTime := time.Date(d.ref.Year(), d.ref.Month(), d.ref.Day(), h, m, sec, 0, d.src)
+	targetTime := srcTime.In(d.target)
 
 	dayOffset := targetTime.Day() - srcTime.Day()
 	if dayOffset > 1 {
@@ -532,62 +775,84 @@ func descFormatTimeWithTZ(hour, minute string, srcLoc, targetLoc *time.Location,
 	} else if dayOffset < -1 {
 		dayOffset = 1
 	}
+	return targetTime, dayOffset
+}
 
-	targetH := targetTime.Hour()
-	period := "AM"
-	displayHour := targetH
-	if targetH >= 12 {
-		period = "PM"
-		if targetH > 12 {
-			displayHour = targetH - 12
+// crossesMidnight reports whether any time of a schedule converts to
+// another day in the target location.
+func (d *describer) crossesMidnight(s *descSchedule) bool {
+	first, last := s.minutes.values[0], s.minutes.values[len(s.minutes.values)-1]
+	for _, h := range s.hours.values {
+		for _, m := range []int{first, last} {
+			if _, offset := d.convert(h, m, 0); offset != 0 {
+				return true
+			}
 		}
 	}
-	if displayHour == 0 {
-		displayHour = 12
-	}
-
-	if short && m == 0 {
-		return fmt.Sprintf("%d%s", displayHour, period), dayOffset
-	}
-	if short {
-		return fmt.Sprintf("%d:%02d%s", displayHour, m, period), dayOffset
-	}
-	return fmt.Sprintf("%d:%02d %s", displayHour, m, period), dayOffset
+	return false
 }
 
-// descFormatHourWithTZ converts hour from srcLoc to targetLoc in 12-hour format.
-func descFormatHourWithTZ(hour string, srcLoc, targetLoc *time.Location, short bool) (string, int) {
-	h, err := strconv.Atoi(hour)
-	if err != nil {
-		return hour + ":00", 0
+// dayGroups splits a schedule on days of the week whose hours convert to
+// different days, such as 1:00 and 23:00 UTC on Monday in New York, into
+// schedules of the hours that share a day offset, so each names its own
+// days.
+func (d *describer) dayGroups(s *descSchedule) []descSchedule {
+	if !s.dowRestricted || s.hours.all() {
+		return []descSchedule{*s}
+	}
+	if _, ok := s.hours.step(); ok {
+		return []descSchedule{*s}
+	}
+	minute := 0
+	if m, ok := s.minutes.single(); ok {
+		minute = m
+	}
+	var offsets []int
+	hours := map[int][]int{}
+	for _, h := range s.hours.values {
+		_, offset := d.convert(h, minute, 0)
+		if hours[offset] == nil {
+			offsets = append(offsets, offset)
+		}
+		hours[offset] = append(hours[offset], h)
 	}
-
-	now := time.Now()
-	srcTime := time.Date(now.Year(), now.Month(), now.Day(), h, 0, 0, 0, srcLoc)
-	targetTime := srcTime.In(targetLoc)
-
-	dayOffset := targetTime.Day() - srcTime.Day()
-	if dayOffset > 1 {
-		dayOffset = -1
-	} else if dayOffset < -1 {
-		dayOffset = 1
+	groups := make([]descSchedule, len(offsets))
+	for i, offset := range offsets {
+		groups[i] = *s
+		groups[i].hours.values = hours[offset]
 	}
+	return groups
+}
 
-	targetH := targetTime.Hour()
-	period := "AM"
-	displayHour := targetH
-	if targetH >= 12 {
-		period = "PM"
-		if targetH > 12 {
-			displayHour = targetH - 12
+// withDST notes on a description of converted times how they move when the
+// offset between the source and target locations changes during the year of
+// the reference time.
+func (d *describer) withDST(desc Description) Description {
+	if d.src == d.target {
+		return desc
+	}
+	offset := func(t time.Time) int {
+		_, src := t.In(d.src).Zone()
+		_, target := t.In(d.target).Zone()
+		return target - src
+	}
+	base := offset(d.ref)
+	shift, varies := 0, false
+	year := d.ref.Year()
+	for day := time.Date(year, time.January, 1, 12, 0, 0, 0, time.UTC); day.Year() == year; day = day.AddDate(0, 0, 1) {
+		if o := offset(day) - base; o != 0 {
+			varies = varies || shift != 0 && o != shift
+			shift = o
 		}
 	}
-	if displayHour == 0 {
-		displayHour = 12
-	}
-
-	if short {
-		return fmt.Sprintf("%d%s", displayHour, period), dayOffset
+	switch {
+	case shift == 0:
+		return desc
+	case varies || shift%3600 != 0:
+		return d.phrase("dst-varies", 0, desc)
+	case shift < 0:
+		return d.phrase("dst-earlier", -shift/3600, desc)
+	default:
+		return d.phrase("dst-later", shift/3600, desc)
 	}
-	return fmt.Sprintf("%d:00 %s", displayHour, period), dayOffset
 }
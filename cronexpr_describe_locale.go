@@ -0,0 +1,777 @@
+package cronexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Translator supplies the language of Describe output.
+//
+// Describe builds a description from messages whose arguments are already
+// translated names, ordinals, times and lists. Message returns a message's
+// template for a count n, in which {n} stands for the count and {1}, {2}, ...
+// for the arguments, so each language sets its own word order and plural
+// forms. The messages, with their arguments, are:
+//
+//	sentence              {1} time, {2} date
+//	at                    {1} list of times
+//	every-minutes         every n minutes
+//	every-minutes-between {1} first, {2} last minute, every n minutes
+//	at-minutes            {1} list of minutes past the hour
+//	hourly                {1} minutes, every n hours
+//	hour-range            {1} minutes, {2} first hour, {3} last hour
+//	hours-between         {1} minutes, {2} first, {3} last hour, every n hours
+//	during-hours          {1} minutes, {2} list of hours
+//	date                  {1} days, {2} months
+//	days-union            {1} days of the month, {2} days of the week
+//	weekday-range         {1} first day, {2} last day
+//	weekdays              {1} list of days
+//	nth-weekday           {1} week ordinal, {2} day; n is the week
+//	last-weekday          {1} day
+//	monthly-weekdays      {1} list of nth-weekday and last-weekday phrases
+//	weekdays-and-monthly  {1} weekdays phrase, {2} as monthly-weekdays
+//	every-days            every n days
+//	every-days-between    {1} first, {2} last ordinal, every n days
+//	day-range             {1}, {2} first and last ordinal, {3}, {4} as numbers
+//	last-day, last-workday
+//	nearest-workday       {1} ordinal
+//	days                  {1} list of ordinals and day- phrases
+//	day-nearest-workday   {1} ordinal
+//	day-last-workday, day-last
+//	month-range           {1} first month, {2} last month
+//	months                {1} list of months
+//	weekday-months        {1} list of months, after days of the week
+//	every-hours           every n hours, for rate() schedules
+//	every-seconds         every n seconds
+//	every-seconds-between {1} first, {2} last second, every n seconds
+//	at-seconds            {1} list of seconds past the minute
+//	seconds-of-minute     {1} at-seconds phrase
+//	with-seconds          {1} seconds, {2} time
+//	years                 {1} list of years
+//	year-range            {1} first year, {2} last year
+//	every-years           {1} first year, every n years
+//	with-years            {1} description, {2} years
+//	span                  {1} first, {2} last of consecutive list items
+//	dst-earlier           {1} description, converted times n hours earlier
+//	                      for part of the year
+//	dst-later             {1} description, n hours later for part of the year
+//	dst-varies            {1} description, converted times that vary otherwise
+//
+// Summary adds the messages:
+//
+//	summary               {1} description, {2} next-run or no-further-runs
+//	summary-last          {1}, {2} as summary, {3} last-run
+//	next-run              {1} duration until, {2} time of the run
+//	last-run              {1} duration since, {2} time of the run
+//	no-further-runs
+//	run-weekday           {1} short day, {2} time, {3} time zone, within a week
+//	run-date              {1} short day, {2} short month, {3} day of the month,
+//	                      {4} year, {5} time, {6} time zone
+//	duration-seconds      n seconds
+//	duration-minutes      n minutes
+//	duration-hours        n hours
+//	duration-hours-mins   {1} hours, {2} minutes
+//	duration-days         n days
+//
+// Idioms replace a literal phrase when the schedule matches them. They are
+// optional: an empty message keeps the literal phrase.
+//
+//	idiom-weekdays        days of the week Monday–Friday
+//	idiom-weekends        days of the week Saturday and Sunday
+//	idiom-business-hours  {1} minutes, hours 9–17 Monday–Friday
+//	idiom-twice-daily     {1} list of two times, every day
+//	idiom-every-other-day days of the month */2
+//	idiom-first-business-day
+//	                      day of the month 1W
+//	idiom-last-business-day
+//	                      day of the month LW
+//	idiom-quarterly       {1} one day of the month, {2} the day as a list
+//	                      item, in months 1,4,7,10
+//	idiom-semiannually    {1} one day of the month, {2} the day as a list
+//	                      item, in months 1,7
+//
+// The description is capitalized after it is assembled.
+type Translator interface {
+	// DayName returns the name of a day of the week, abbreviated if short.
+	DayName(day time.Weekday, short bool) string
+	// MonthName returns the name of a month, abbreviated if short.
+	MonthName(month time.Month, short bool) string
+	// Ordinal returns a day of the month as an ordinal, such as "1st".
+	Ordinal(n int) string
+	// WeekOrdinal returns the ordinal word for the n-th (1-5) occurrence of
+	// a weekday in a month, such as "first".
+	WeekOrdinal(n int) string
+	// Join joins a list of items, such as "A, B, and C". Describe passes
+	// placeholders for the items, so Join should not change them.
+	Join(items []string) string
+	// Time formats a time of day in the given style.
+	Time(hour, minute, second int, style TimeStyle) string
+	// Message returns the template of the message key for count n,
+	// preferring an abbreviated form if short.
+	Message(key string, n int, short bool) string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Translator{
+		"en": english,
+		"de": german,
+		"fr": french,
+		"es": spanish,
+		"ja": japanese,
+	}
+)
+
+// phrasebook is a Translator whose messages are overridden by
+// DescribeOptions.Phrases.
+type phrasebook struct {
+	Translator
+	phrases map[string]string
+}
+
+// Message returns the override for key, or key+".short" if short, in its
+// form for n by the plural rule of the translator's language, or else the
+// translator's message.
+func (p phrasebook) Message(key string, n int, short bool) string {
+	msg, ok := p.phrases[key+".short"]
+	if !short || !ok {
+		msg, ok = p.phrases[key]
+	}
+	if !ok {
+		return p.Translator.Message(key, n, short)
+	}
+	plural := pluralOne
+	if c, ok := p.Translator.(*catalog); ok {
+		plural = c.plural
+	}
+	forms := strings.Split(msg, "|")
+	return forms[min(plural(n), len(forms)-1)]
+}
+
+// RegisterLocale makes a translator available to DescribeOptions.Locale
+// under a language tag, replacing any translator registered for it. It is
+// safe to call concurrently with Describe.
+func RegisterLocale(tag string, t Translator) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(tag)] = t
+}
+
+// LookupLocale returns the translator for a language tag, trying the base
+// language of a tag such as "de-AT" when the tag itself is not registered.
+// For unknown tags it returns the English translator and false.
+func LookupLocale(tag string) (Translator, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	for {
+		if t, ok := locales[tag]; ok {
+			return t, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return locales["en"], false
+		}
+		tag = tag[:i]
+	}
+}
+
+// TimeStyle selects how Translator.Time formats a time of day.
+type TimeStyle struct {
+	Short   bool // abbreviated, such as "9AM"
+	Hour24  bool // on a 24-hour clock in languages that default to 12 hours
+	Seconds bool // with the second
+}
+
+// catalog is a Translator built from tables.
+type catalog struct {
+	days, shortDays     [7]string
+	months, shortMonths [12]string
+	weekOrdinals        [5]string
+	ordinal             func(n int) string
+	sep, and            string // list separator and the word before the last item
+	serialComma         bool   // "A, B, and C"
+	time                func(hour, minute, second int, style TimeStyle) string
+	plural              func(n int) int // index of the form for n in "one|other" messages
+	messages            map[string]string
+}
+
+func (c *catalog) DayName(day time.Weekday, short bool) string {
+	if short {
+		return c.shortDays[day]
+	}
+	return c.days[day]
+}
+
+func (c *catalog) MonthName(month time.Month, short bool) string {
+	if short {
+		return c.shortMonths[month-1]
+	}
+	return c.months[month-1]
+}
+
+func (c *catalog) Ordinal(n int) string { return c.ordinal(n) }
+
+func (c *catalog) WeekOrdinal(n int) string { return c.weekOrdinals[n-1] }
+
+func (c *catalog) Join(items []string) string {
+	n := len(items)
+	switch {
+	case n == 0:
+		return ""
+	case n == 1:
+		return items[0]
+	case c.and == "":
+		return strings.Join(items, c.sep)
+	}
+	last := c.and
+	if c.serialComma && n > 2 {
+		last = strings.TrimRight(c.sep, " ") + last
+	}
+	return strings.Join(items[:n-1], c.sep) + last + items[n-1]
+}
+
+func (c *catalog) Time(hour, minute, second int, style TimeStyle) string {
+	return c.time(hour, minute, second, style)
+}
+
+// Message looks up key, or key+".short" if short, and picks the plural form
+// for n from the "|"-separated forms.
+func (c *catalog) Message(key string, n int, short bool) string {
+	msg, ok := c.messages[key+".short"]
+	if !short || !ok {
+		msg = c.messages[key]
+	}
+	forms := strings.Split(msg, "|")
+	return forms[min(c.plural(n), len(forms)-1)]
+}
+
+// pluralOne gives the singular for exactly one, as in English, German and
+// Spanish.
+func pluralOne(n int) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// pluralFrench gives the singular for zero and one.
+func pluralFrench(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return 1
+}
+
+// descClock formats a time as digits: 9:05, or 9:05:30 with seconds.
+func descClock(hour, minute, second int, seconds bool) string {
+	if seconds {
+		return fmt.Sprintf("%d:%02d:%02d", hour, minute, second)
+	}
+	return fmt.Sprintf("%d:%02d", hour, minute)
+}
+
+var english = &catalog{
+	days:         [7]string(descDayNames),
+	shortDays:    [7]string(descDayShortNames),
+	months:       [12]string(descMonthNames[1:]),
+	shortMonths:  [12]string(descMonthShortNames[1:]),
+	weekOrdinals: [5]string{"first", "second", "third", "fourth", "fifth"},
+	ordinal: func(n int) string {
+		if n%100 >= 11 && n%100 <= 13 {
+			return strconv.Itoa(n) + "th"
+		}
+		switch n % 10 {
+		case 1:
+			return strconv.Itoa(n) + "st"
+		case 2:
+			return strconv.Itoa(n) + "nd"
+		case 3:
+			return strconv.Itoa(n) + "rd"
+		default:
+			return strconv.Itoa(n) + "th"
+		}
+	},
+	sep: ", ", and: " and ", serialComma: true,
+	time: func(hour, minute, second int, style TimeStyle) string {
+		if style.Hour24 {
+			return descClock(hour, minute, second, style.Seconds)
+		}
+		period := "AM"
+		if hour >= 12 {
+			period = "PM"
+		}
+		displayHour := hour % 12
+		if displayHour == 0 {
+			displayHour = 12
+		}
+		clock := descClock(displayHour, minute, second, style.Seconds)
+		switch {
+		case style.Short && minute == 0 && !style.Seconds:
+			return fmt.Sprintf("%d%s", displayHour, period)
+		case style.Short:
+			return clock + period
+		}
+		return clock + " " + period
+	},
+	plural: pluralOne,
+	messages: map[string]string{
+		"sentence":                       "{1}, {2}",
+		"at":                             "at {1}",
+		"every-minutes":                  "every minute|every {n} minutes",
+		"every-minutes.short":            "every min|every {n} mins",
+		"every-minutes-between":          "every {n} minutes from minute {1} through {2}",
+		"every-minutes-between.short":    "every {n} mins from min {1} through {2}",
+		"at-minutes":                     "at minute {1}|at minutes {1}",
+		"at-minutes.short":               "at min {1}|at mins {1}",
+		"hourly":                         "{1}, every hour|{1}, every {n} hours",
+		"hour-range":                     "{1}, {2}–{3}",
+		"hours-between":                  "{1}, every {n} hours from {2} through {3}",
+		"during-hours":                   "{1}, during the {2} hour|{1}, during the {2} hours",
+		"date":                           "{1} {2}",
+		"days-union":                     "{1} and {2}",
+		"weekday-range":                  "{1}–{2}",
+		"weekdays":                       "{1} only",
+		"nth-weekday":                    "{1} {2}",
+		"last-weekday":                   "last {1}",
+		"monthly-weekdays":               "on the {1} of the month",
+		"weekdays-and-monthly":           "{1} and on the {2} of the month",
+		"every-days":                     "every day|every {n} days",
+		"every-days-between":             "every {n} days from the {1} through the {2}",
+		"day-range":                      "on the {1}–{2} of the month",
+		"day-range.short":                "days {3}–{2}",
+		"last-day":                       "on the last day of the month",
+		"last-day.short":                 "last day of month",
+		"last-workday":                   "on the last weekday of the month",
+		"last-workday.short":             "last weekday of month",
+		"nearest-workday":                "on the weekday nearest the {1} of the month",
+		"nearest-workday.short":          "weekday nearest the {1}",
+		"days":                           "on the {1} of the month",
+		"days.short":                     "on the {1}",
+		"day-nearest-workday":            "weekday nearest the {1}",
+		"day-last-workday":               "last weekday",
+		"day-last":                       "last day",
+		"month-range":                    "in {1}–{2}",
+		"month-range.short":              "{1}–{2}",
+		"months":                         "only in {1}|in {1}",
+		"weekday-months":                 "in {1}",
+		"every-hours":                    "every hour|every {n} hours",
+		"every-seconds":                  "every second|every {n} seconds",
+		"every-seconds.short":            "every sec|every {n} secs",
+		"every-seconds-between":          "every {n} seconds from second {1} through {2}",
+		"every-seconds-between.short":    "every {n} secs from sec {1} through {2}",
+		"at-seconds":                     "at second {1}|at seconds {1}",
+		"at-seconds.short":               "at sec {1}|at secs {1}",
+		"seconds-of-minute":              "{1} of every minute",
+		"with-seconds":                   "{1}, {2}",
+		"years":                          "only in {1}|in {1}",
+		"year-range":                     "in {1}–{2}",
+		"every-years":                    "every year from {1}|every {n} years from {1}",
+		"with-years":                     "{1}, {2}",
+		"span":                           "{1}–{2}",
+		"dst-earlier":                    "{1} (an hour earlier for part of the year)|{1} ({n} hours earlier for part of the year)",
+		"dst-later":                      "{1} (an hour later for part of the year)|{1} ({n} hours later for part of the year)",
+		"dst-varies":                     "{1} (varies with daylight saving time)",
+		"source-time":                    "{1} ({2} time)",
+		"summary":                        "{1} — {2}",
+		"summary-last":                   "{1} — {2}, {3}",
+		"next-run":                       "next run in {1} ({2})",
+		"last-run":                       "last run {1} ago ({2})",
+		"no-further-runs":                "no further runs",
+		"run-weekday":                    "{1} {2} {3}",
+		"run-date":                       "{1}, {2} {3}, {4}, {5} {6}",
+		"duration-seconds":               "{n}s",
+		"duration-minutes":               "{n}m",
+		"duration-hours":                 "{n}h",
+		"duration-hours-mins":            "{1}h {2}m",
+		"duration-days":                  "1 day|{n} days",
+		"idiom-weekdays":                 "on weekdays",
+		"idiom-weekdays.short":           "weekdays",
+		"idiom-weekends":                 "on weekends",
+		"idiom-weekends.short":           "weekends",
+		"idiom-business-hours":           "{1}, during business hours",
+		"idiom-twice-daily":              "twice daily at {1}",
+		"idiom-every-other-day":          "every other day of the month",
+		"idiom-first-business-day":       "on the first business day of the month",
+		"idiom-first-business-day.short": "first business day of month",
+		"idiom-last-business-day":        "on the last business day of the month",
+		"idiom-last-business-day.short":  "last business day of month",
+		"idiom-quarterly":                "quarterly {1}",
+		"idiom-semiannually":             "semiannually {1}",
+	},
+}
+
+var german = &catalog{
+	days:         [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	shortDays:    [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	shortMonths:  [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	weekOrdinals: [5]string{"ersten", "zweiten", "dritten", "vierten", "fünften"},
+	ordinal:      func(n int) string { return strconv.Itoa(n) + "." },
+	sep:          ", ", and: " und ",
+	time: func(hour, minute, second int, style TimeStyle) string {
+		if style.Short && minute == 0 && !style.Seconds {
+			return fmt.Sprintf("%d Uhr", hour)
+		}
+		return descClock(hour, minute, second, style.Seconds) + " Uhr"
+	},
+	plural: pluralOne,
+	messages: map[string]string{
+		"sentence":                 "{1}, {2}",
+		"at":                       "um {1}",
+		"every-minutes":            "jede Minute|alle {n} Minuten",
+		"every-minutes.short":      "jede Min.|alle {n} Min.",
+		"every-minutes-between":    "alle {n} Minuten von Minute {1} bis {2}",
+		"at-minutes":               "zur Minute {1}|zu den Minuten {1}",
+		"hourly":                   "{1}, jede Stunde|{1}, alle {n} Stunden",
+		"hour-range":               "{1}, {2}–{3}",
+		"hours-between":            "{1}, alle {n} Stunden von {2} bis {3}",
+		"during-hours":             "{1}, in der Stunde ab {2}|{1}, in den Stunden ab {2}",
+		"date":                     "{1} {2}",
+		"days-union":               "{1} und {2}",
+		"weekday-range":            "{1}–{2}",
+		"weekdays":                 "nur {1}",
+		"nth-weekday":              "{1} {2}",
+		"last-weekday":             "letzten {1}",
+		"monthly-weekdays":         "am {1} des Monats",
+		"weekdays-and-monthly":     "{1} und am {2} des Monats",
+		"every-days":               "jeden Tag|alle {n} Tage",
+		"every-days-between":       "alle {n} Tage vom {1} bis {2}",
+		"day-range":                "vom {1} bis {2} des Monats",
+		"day-range.short":          "Tage {3}–{4}",
+		"last-day":                 "am letzten Tag des Monats",
+		"last-day.short":           "letzter Tag des Monats",
+		"last-workday":             "am letzten Werktag des Monats",
+		"last-workday.short":       "letzter Werktag des Monats",
+		"nearest-workday":          "am nächstgelegenen Werktag zum {1}",
+		"days":                     "am {1} des Monats",
+		"days.short":               "am {1}",
+		"day-nearest-workday":      "nächstgelegenen Werktag zum {1}",
+		"day-last-workday":         "letzten Werktag",
+		"day-last":                 "letzten Tag",
+		"month-range":              "von {1} bis {2}",
+		"months":                   "im {1}",
+		"weekday-months":           "im {1}",
+		"every-hours":              "jede Stunde|alle {n} Stunden",
+		"every-seconds":            "jede Sekunde|alle {n} Sekunden",
+		"every-seconds.short":      "jede Sek.|alle {n} Sek.",
+		"every-seconds-between":    "alle {n} Sekunden von Sekunde {1} bis {2}",
+		"at-seconds":               "in Sekunde {1}|in den Sekunden {1}",
+		"seconds-of-minute":        "{1} jeder Minute",
+		"with-seconds":             "{1}, {2}",
+		"years":                    "nur {1}|in den Jahren {1}",
+		"year-range":               "von {1} bis {2}",
+		"every-years":              "jedes Jahr ab {1}|alle {n} Jahre ab {1}",
+		"with-years":               "{1}, {2}",
+		"span":                     "{1}–{2}",
+		"dst-earlier":              "{1} (einen Teil des Jahres eine Stunde früher)|{1} (einen Teil des Jahres {n} Stunden früher)",
+		"dst-later":                "{1} (einen Teil des Jahres eine Stunde später)|{1} (einen Teil des Jahres {n} Stunden später)",
+		"dst-varies":               "{1} (abhängig von der Sommerzeit)",
+		"source-time":              "{1} (Zeit in {2})",
+		"summary":                  "{1} — {2}",
+		"summary-last":             "{1} — {2}, {3}",
+		"next-run":                 "nächste Ausführung in {1} ({2})",
+		"last-run":                 "letzte Ausführung vor {1} ({2})",
+		"no-further-runs":          "keine weiteren Ausführungen",
+		"run-weekday":              "{1} {2} {3}",
+		"run-date":                 "{1}, {3}. {2} {4}, {5} {6}",
+		"duration-seconds":         "{n} s",
+		"duration-minutes":         "{n} min",
+		"duration-hours":           "{n} h",
+		"duration-hours-mins":      "{1} h {2} min",
+		"duration-days":            "1 Tag|{n} Tagen",
+		"idiom-weekdays":           "wochentags",
+		"idiom-weekends":           "am Wochenende",
+		"idiom-business-hours":     "{1}, während der Geschäftszeiten",
+		"idiom-twice-daily":        "zweimal täglich um {1}",
+		"idiom-every-other-day":    "jeden zweiten Tag des Monats",
+		"idiom-first-business-day": "am ersten Werktag des Monats",
+		"idiom-last-business-day":  "am letzten Werktag des Monats",
+		"idiom-quarterly":          "vierteljährlich {1}",
+		"idiom-semiannually":       "halbjährlich {1}",
+	},
+}
+
+var french = &catalog{
+	days:         [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	shortDays:    [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	months:       [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	shortMonths:  [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	weekOrdinals: [5]string{"premier", "deuxième", "troisième", "quatrième", "cinquième"},
+	ordinal: func(n int) string {
+		if n == 1 {
+			return "1er"
+		}
+		return strconv.Itoa(n)
+	},
+	sep: ", ", and: " et ",
+	time: func(hour, minute, second int, style TimeStyle) string {
+		switch {
+		case style.Seconds:
+			return fmt.Sprintf("%d h %02d min %02d s", hour, minute, second)
+		case style.Short && minute == 0:
+			return fmt.Sprintf("%d h", hour)
+		}
+		return fmt.Sprintf("%d h %02d", hour, minute)
+	},
+	plural: pluralFrench,
+	messages: map[string]string{
+		"sentence":                 "{1}, {2}",
+		"at":                       "à {1}",
+		"every-minutes":            "chaque minute|toutes les {n} minutes",
+		"every-minutes.short":      "chaque min|toutes les {n} min",
+		"every-minutes-between":    "toutes les {n} minutes de la minute {1} à {2}",
+		"at-minutes":               "à la minute {1}|aux minutes {1}",
+		"hourly":                   "{1}, chaque heure|{1}, toutes les {n} heures",
+		"hour-range":               "{1}, de {2} à {3}",
+		"hours-between":            "{1}, toutes les {n} heures de {2} à {3}",
+		"during-hours":             "{1}, pendant l'heure de {2}|{1}, pendant les heures de {2}",
+		"date":                     "{1} {2}",
+		"days-union":               "{1} et {2}",
+		"weekday-range":            "du {1} au {2}",
+		"weekdays":                 "le {1} uniquement|les {1} uniquement",
+		"nth-weekday":              "{1} {2}",
+		"last-weekday":             "dernier {1}",
+		"monthly-weekdays":         "le {1} du mois",
+		"weekdays-and-monthly":     "{1} et le {2} du mois",
+		"every-days":               "chaque jour|tous les {n} jours",
+		"every-days-between":       "tous les {n} jours du {1} au {2}",
+		"day-range":                "du {1} au {2} du mois",
+		"day-range.short":          "jours {3}–{4}",
+		"last-day":                 "le dernier jour du mois",
+		"last-day.short":           "dernier jour du mois",
+		"last-workday":             "le dernier jour ouvré du mois",
+		"last-workday.short":       "dernier jour ouvré du mois",
+		"nearest-workday":          "le jour ouvré le plus proche du {1} du mois",
+		"nearest-workday.short":    "jour ouvré le plus proche du {1}",
+		"days":                     "le {1} du mois|les {1} du mois",
+		"days.short":               "le {1}|les {1}",
+		"day-nearest-workday":      "jour ouvré le plus proche du {1}",
+		"day-last-workday":         "dernier jour ouvré",
+		"day-last":                 "dernier jour",
+		"month-range":              "de {1} à {2}",
+		"months":                   "en {1}",
+		"weekday-months":           "en {1}",
+		"every-hours":              "chaque heure|toutes les {n} heures",
+		"every-seconds":            "chaque seconde|toutes les {n} secondes",
+		"every-seconds.short":      "chaque s|toutes les {n} s",
+		"every-seconds-between":    "toutes les {n} secondes de la seconde {1} à {2}",
+		"at-seconds":               "à la seconde {1}|aux secondes {1}",
+		"seconds-of-minute":        "{1} de chaque minute",
+		"with-seconds":             "{1}, {2}",
+		"years":                    "uniquement en {1}|en {1}",
+		"year-range":               "de {1} à {2}",
+		"every-years":              "chaque année à partir de {1}|tous les {n} ans à partir de {1}",
+		"with-years":               "{1}, {2}",
+		"span":                     "{1}–{2}",
+		"dst-earlier":              "{1} (une heure plus tôt une partie de l'année)|{1} ({n} heures plus tôt une partie de l'année)",
+		"dst-later":                "{1} (une heure plus tard une partie de l'année)|{1} ({n} heures plus tard une partie de l'année)",
+		"dst-varies":               "{1} (selon l'heure d'été)",
+		"source-time":              "{1} (heure de {2})",
+		"summary":                  "{1} — {2}",
+		"summary-last":             "{1} — {2}, {3}",
+		"next-run":                 "prochaine exécution dans {1} ({2})",
+		"last-run":                 "dernière exécution il y a {1} ({2})",
+		"no-further-runs":          "aucune autre exécution",
+		"run-weekday":              "{1} {2} {3}",
+		"run-date":                 "{1} {3} {2} {4}, {5} {6}",
+		"duration-seconds":         "{n} s",
+		"duration-minutes":         "{n} min",
+		"duration-hours":           "{n} h",
+		"duration-hours-mins":      "{1} h {2} min",
+		"duration-days":            "1 jour|{n} jours",
+		"idiom-weekdays":           "en semaine",
+		"idiom-weekends":           "le week-end",
+		"idiom-business-hours":     "{1}, pendant les heures ouvrables",
+		"idiom-twice-daily":        "deux fois par jour à {1}",
+		"idiom-every-other-day":    "un jour sur deux dans le mois",
+		"idiom-first-business-day": "le premier jour ouvré du mois",
+		"idiom-last-business-day":  "le dernier jour ouvré du mois",
+		"idiom-quarterly":          "chaque trimestre {1}",
+		"idiom-semiannually":       "chaque semestre {1}",
+	},
+}
+
+var spanish = &catalog{
+	days:         [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	shortDays:    [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	months:       [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	shortMonths:  [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	weekOrdinals: [5]string{"primer", "segundo", "tercer", "cuarto", "quinto"},
+	ordinal:      strconv.Itoa,
+	sep:          ", ", and: " y ",
+	time: func(hour, minute, second int, style TimeStyle) string {
+		return descClock(hour, minute, second, style.Seconds)
+	},
+	plural: pluralOne,
+	messages: map[string]string{
+		"sentence":                 "{1}, {2}",
+		"at":                       "a las {1}",
+		"every-minutes":            "cada minuto|cada {n} minutos",
+		"every-minutes.short":      "cada min|cada {n} min",
+		"every-minutes-between":    "cada {n} minutos del minuto {1} al {2}",
+		"at-minutes":               "en el minuto {1}|en los minutos {1}",
+		"hourly":                   "{1}, cada hora|{1}, cada {n} horas",
+		"hour-range":               "{1}, de {2} a {3}",
+		"hours-between":            "{1}, cada {n} horas de {2} a {3}",
+		"during-hours":             "{1}, durante la hora de las {2}|{1}, durante las horas de las {2}",
+		"date":                     "{1} {2}",
+		"days-union":               "{1} y {2}",
+		"weekday-range":            "de {1} a {2}",
+		"weekdays":                 "solo el {1}|solo los {1}",
+		"nth-weekday":              "{1} {2}",
+		"last-weekday":             "último {1}",
+		"monthly-weekdays":         "el {1} del mes",
+		"weekdays-and-monthly":     "{1} y el {2} del mes",
+		"every-days":               "cada día|cada {n} días",
+		"every-days-between":       "cada {n} días del {1} al {2}",
+		"day-range":                "del {1} al {2} del mes",
+		"day-range.short":          "días {3}–{4}",
+		"last-day":                 "el último día del mes",
+		"last-day.short":           "último día del mes",
+		"last-workday":             "el último día hábil del mes",
+		"last-workday.short":       "último día hábil del mes",
+		"nearest-workday":          "el día hábil más cercano al {1} del mes",
+		"nearest-workday.short":    "día hábil más cercano al {1}",
+		"days":                     "el día {1} del mes|los días {1} del mes",
+		"days.short":               "el día {1}|los días {1}",
+		"day-nearest-workday":      "día hábil más cercano al {1}",
+		"day-last-workday":         "último día hábil",
+		"day-last":                 "último día",
+		"month-range":              "de {1} a {2}",
+		"months":                   "en {1}",
+		"weekday-months":           "en {1}",
+		"every-hours":              "cada hora|cada {n} horas",
+		"every-seconds":            "cada segundo|cada {n} segundos",
+		"every-seconds.short":      "cada s|cada {n} s",
+		"every-seconds-between":    "cada {n} segundos del segundo {1} al {2}",
+		"at-seconds":               "en el segundo {1}|en los segundos {1}",
+		"seconds-of-minute":        "{1} de cada minuto",
+		"with-seconds":             "{1}, {2}",
+		"years":                    "solo en {1}|en {1}",
+		"year-range":               "de {1} a {2}",
+		"every-years":              "cada año desde {1}|cada {n} años desde {1}",
+		"with-years":               "{1}, {2}",
+		"span":                     "{1}–{2}",
+		"dst-earlier":              "{1} (una hora antes parte del año)|{1} ({n} horas antes parte del año)",
+		"dst-later":                "{1} (una hora después parte del año)|{1} ({n} horas después parte del año)",
+		"dst-varies":               "{1} (según el horario de verano)",
+		"source-time":              "{1} (hora de {2})",
+		"summary":                  "{1} — {2}",
+		"summary-last":             "{1} — {2}, {3}",
+		"next-run":                 "próxima ejecución en {1} ({2})",
+		"last-run":                 "última ejecución hace {1} ({2})",
+		"no-further-runs":          "no hay más ejecuciones",
+		"run-weekday":              "{1} {2} {3}",
+		"run-date":                 "{1} {3} {2} {4}, {5} {6}",
+		"duration-seconds":         "{n} s",
+		"duration-minutes":         "{n} min",
+		"duration-hours":           "{n} h",
+		"duration-hours-mins":      "{1} h {2} min",
+		"duration-days":            "1 día|{n} días",
+		"idiom-weekdays":           "entre semana",
+		"idiom-weekends":           "los fines de semana",
+		"idiom-business-hours":     "{1}, en horario laboral",
+		"idiom-twice-daily":        "dos veces al día a las {1}",
+		"idiom-every-other-day":    "cada dos días del mes",
+		"idiom-first-business-day": "el primer día hábil del mes",
+		"idiom-last-business-day":  "el último día hábil del mes",
+		"idiom-quarterly":          "cada trimestre {1}",
+		"idiom-semiannually":       "cada semestre {1}",
+	},
+}
+
+var japanese = &catalog{
+	days:         [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	shortDays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	months:       [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	shortMonths:  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	weekOrdinals: [5]string{"第1", "第2", "第3", "第4", "第5"},
+	ordinal:      func(n int) string { return strconv.Itoa(n) + "日" },
+	sep:          "、",
+	time: func(hour, minute, second int, style TimeStyle) string {
+		if !style.Short {
+			return descClock(hour, minute, second, style.Seconds)
+		}
+		out := fmt.Sprintf("%d時", hour)
+		if minute != 0 || style.Seconds {
+			out += fmt.Sprintf("%d分", minute)
+		}
+		if style.Seconds {
+			out += fmt.Sprintf("%d秒", second)
+		}
+		return out
+	},
+	plural: pluralOne,
+	messages: map[string]string{
+		"sentence":                 "{2}、{1}",
+		"at":                       "{1}",
+		"every-minutes":            "毎分|{n}分ごと",
+		"every-minutes-between":    "{1}分から{2}分まで{n}分ごと",
+		"at-minutes":               "{1}分",
+		"hourly":                   "毎時{1}|{n}時間ごと、{1}",
+		"hour-range":               "{2}～{3}、{1}",
+		"hours-between":            "{2}から{3}まで{n}時間ごと、{1}",
+		"during-hours":             "{2}の時間帯、{1}",
+		"date":                     "{2}の{1}",
+		"days-union":               "{1}と{2}",
+		"weekday-range":            "{1}～{2}",
+		"weekdays":                 "{1}のみ",
+		"nth-weekday":              "{1}{2}",
+		"last-weekday":             "最終{1}",
+		"monthly-weekdays":         "毎月{1}",
+		"weekdays-and-monthly":     "{1}と毎月{2}",
+		"every-days":               "毎日|{n}日ごと",
+		"every-days-between":       "毎月{1}から{2}まで{n}日ごと",
+		"day-range":                "毎月{1}～{2}",
+		"last-day":                 "毎月末日",
+		"last-workday":             "毎月最終平日",
+		"nearest-workday":          "毎月{1}に最も近い平日",
+		"days":                     "毎月{1}",
+		"day-nearest-workday":      "{1}に最も近い平日",
+		"day-last-workday":         "最終平日",
+		"day-last":                 "末日",
+		"month-range":              "{1}～{2}",
+		"months":                   "{1}",
+		"weekday-months":           "{1}",
+		"every-hours":              "毎時|{n}時間ごと",
+		"every-seconds":            "毎秒|{n}秒ごと",
+		"every-seconds-between":    "{1}秒から{2}秒まで{n}秒ごと",
+		"at-seconds":               "{1}秒",
+		"seconds-of-minute":        "毎分{1}",
+		"with-seconds":             "{2}、{1}",
+		"years":                    "{1}年のみ|{1}年",
+		"year-range":               "{1}年～{2}年",
+		"every-years":              "{1}年から毎年|{1}年から{n}年ごと",
+		"with-years":               "{2}、{1}",
+		"span":                     "{1}～{2}",
+		"dst-earlier":              "{1}（一部の期間は{n}時間早い）",
+		"dst-later":                "{1}（一部の期間は{n}時間遅い）",
+		"dst-varies":               "{1}（夏時間により変動）",
+		"source-time":              "{1}（{2}時間）",
+		"summary":                  "{1} — {2}",
+		"summary-last":             "{1} — {2}、{3}",
+		"next-run":                 "次回は{1}後（{2}）",
+		"last-run":                 "前回は{1}前（{2}）",
+		"no-further-runs":          "今後の実行なし",
+		"run-weekday":              "{1} {2} {3}",
+		"run-date":                 "{4}年{2}{3}日（{1}） {5} {6}",
+		"duration-seconds":         "{n}秒",
+		"duration-minutes":         "{n}分",
+		"duration-hours":           "{n}時間",
+		"duration-hours-mins":      "{1}時間{2}分",
+		"duration-days":            "{n}日",
+		"idiom-weekdays":           "平日",
+		"idiom-weekends":           "週末",
+		"idiom-business-hours":     "営業時間内、{1}",
+		"idiom-twice-daily":        "1日2回、{1}",
+		"idiom-every-other-day":    "毎月奇数日",
+		"idiom-first-business-day": "毎月最初の営業日",
+		"idiom-last-business-day":  "毎月最後の営業日",
+		"idiom-quarterly":          "四半期ごと（1月・4月・7月・10月の{2}）",
+		"idiom-semiannually":       "半年ごと（1月・7月の{2}）",
+	},
+}
@@ -0,0 +1,134 @@
+package cronexpr_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestDescribeLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		expr   string
+		short  bool
+		want   string
+	}{
+		{"en", "0 9 * * 1-5", false, "At 9:00 AM, on weekdays"},
+		{"en", "*/5 * * * *", true, "Every 5 mins"},
+		{"en", "0 12 1,15,28 * *", false, "At 12:00 PM, on the 1st, 15th, and 28th of the month"},
+
+		{"de", "0 9 * * 1-5", false, "Um 9:00 Uhr, wochentags"},
+		{"de", "0 9 * * 1-4", true, "Um 9 Uhr, Mo–Do"},
+		{"de", "*/1 * * * *", false, "Jede Minute"},
+		{"de", "*/15 * * * *", false, "Alle 15 Minuten"},
+		{"de", "0 12 1,15,28 * *", false, "Um 12:00 Uhr, am 1., 15. und 28. des Monats"},
+		{"de", "0 18 * * 5L", false, "Um 18:00 Uhr, am letzten Freitag des Monats"},
+		{"de", "0 9 * * 2#3", false, "Um 9:00 Uhr, am dritten Dienstag des Monats"},
+		{"de", "0 0 1 3 *", false, "Um 0:00 Uhr, am 1. des Monats im März"},
+
+		{"fr", "0 9 * * 1-5", false, "À 9 h 00, en semaine"},
+		{"fr", "*/15 * * * *", false, "Toutes les 15 minutes"},
+		{"fr", "0 12 1 * *", false, "À 12 h 00, le 1er du mois"},
+		{"fr", "0 12 1,15 * *", false, "À 12 h 00, les 1er et 15 du mois"},
+		{"fr", "0 9 * * 2,4", true, "À 9 h, les mar. et jeu. uniquement"},
+		{"fr", "0 18 * * 5L", false, "À 18 h 00, le dernier vendredi du mois"},
+
+		{"es", "0 9 * * 1-5", false, "A las 9:00, entre semana"},
+		{"es", "* * * * *", false, "Cada minuto"},
+		{"es", "*/15 * * * *", false, "Cada 15 minutos"},
+		{"es", "0 12 1,15 * *", false, "A las 12:00, los días 1 y 15 del mes"},
+		{"es", "0 9 * * 2#3", false, "A las 9:00, el tercer martes del mes"},
+		{"es", "0 0 L * *", false, "A las 0:00, el último día del mes"},
+
+		{"ja", "0 9 * * 1-5", false, "平日、9:00"},
+		{"ja", "0 9 * * 1-5", true, "平日、9時"},
+		{"ja", "*/15 * * * *", false, "15分ごと"},
+		{"ja", "0 12 1,15 * *", false, "毎月1日、15日、12:00"},
+		{"ja", "0 18 * * 5L", false, "毎月最終金曜日、18:00"},
+		{"ja", "0 0 * 1 1", false, "1月の月曜日のみ、0:00"},
+		{"ja", "0 0 1 1,4,7,10 *", false, "四半期ごと（1月・4月・7月・10月の1日）、0:00"},
+		{"ja", "0 0 L 1,7 *", false, "半年ごと（1月・7月の末日）、0:00"},
+		{"ja", "0 3 */2 * *", false, "毎月奇数日、3:00"},
+
+		// Regional tags fall back to the base language, unknown ones to English.
+		{"de-AT", "0 9 * * *", false, "Um 9:00 Uhr"},
+		{"pt_BR", "0 9 * * *", false, "At 9:00 AM"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.expr, func(t *testing.T) {
+			got := cronexpr.MustParse(tt.expr).Describe(&cronexpr.DescribeOptions{Locale: tt.locale, Short: tt.short})
+			if got != tt.want {
+				t.Errorf("Describe = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeLocale_Rate(t *testing.T) {
+	expr, err := cronexpr.ParseEventBridge("rate(2 hours)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := expr.Describe(&cronexpr.DescribeOptions{Locale: "fr"}); got != "Toutes les 2 heures" {
+		t.Errorf("Describe = %q", got)
+	}
+}
+
+// shouting wraps the English translator, upper-casing day names.
+type shouting struct{ cronexpr.Translator }
+
+func (s shouting) DayName(day time.Weekday, short bool) string {
+	return strings.ToUpper(s.Translator.DayName(day, short))
+}
+
+func TestRegisterLocale(t *testing.T) {
+	en, ok := cronexpr.LookupLocale("en-US")
+	if !ok {
+		t.Fatal("LookupLocale(en-US) not found")
+	}
+	if _, ok := cronexpr.LookupLocale("xx"); ok {
+		t.Error("LookupLocale(xx) found")
+	}
+	cronexpr.RegisterLocale("en-x-shout", shouting{en})
+	expr := cronexpr.MustParse("0 9 * * 1-5")
+	if got, want := expr.Describe(&cronexpr.DescribeOptions{Locale: "en-x-shout", Literal: true}), "At 9:00 AM, MONDAY–FRIDAY"; got != want {
+		t.Errorf("Describe = %q, want %q", got, want)
+	}
+	if got, want := expr.Describe(&cronexpr.DescribeOptions{Locale: "de", Translator: shouting{en}, Literal: true}), "At 9:00 AM, MONDAY–FRIDAY"; got != want {
+		t.Errorf("Describe with Translator = %q, want %q", got, want)
+	}
+}
+
+func TestDescribe_Phrases(t *testing.T) {
+	phrases := map[string]string{
+		"every-minutes":  "each minute|each {n} minutes",
+		"days":           "monthly on day {1}|monthly on days {1}",
+		"idiom-weekdays": "weekdays",
+		"idiom-weekends": "on weekends",
+	}
+	tests := []struct {
+		expr   string
+		locale string
+		short  bool
+		want   string
+	}{
+		{"* * * * *", "", false, "Each minute"},
+		{"* * * * *", "", true, "Each minute"},
+		{"*/5 * * * *", "", false, "Each 5 minutes"},
+		{"0 0 1 * *", "", false, "At 12:00 AM, monthly on day 1st"},
+		{"0 9 * * 1-5", "", false, "At 9:00 AM, weekdays"},
+		{"0 9 * * 0,6", "", false, "At 9:00 AM, on weekends"},
+		{"0 9 * * 1-4", "", false, "At 9:00 AM, Monday–Thursday"},
+		{"0 9 * * 1-5", "de", false, "Um 9:00 Uhr, weekdays"},
+		{"0 9 * * 1-5,5L", "", false, "At 9:00 AM, weekdays and on the last Friday of the month"},
+		{"0 9 15W * *", "", false, "At 9:00 AM, on the weekday nearest the 15th of the month"},
+	}
+	for _, tt := range tests {
+		opts := &cronexpr.DescribeOptions{Locale: tt.locale, Short: tt.short, Phrases: phrases}
+		if got := cronexpr.MustParse(tt.expr).Describe(opts); got != tt.want {
+			t.Errorf("Describe(%q, %s, short=%v) = %q, want %q", tt.expr, tt.locale, tt.short, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package cronexpr
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// PartKind is the kind of value a DescribePart stands for.
+type PartKind int
+
+const (
+	// TextPart is the wording around the values, such as "At " or ", ".
+	TextPart PartKind = iota
+	// TimePart is a time of day. Its Values are the hour, minute and second
+	// of the expression.
+	TimePart
+	// IntervalPart is the n of a step, such as "15" in "every 15 minutes".
+	IntervalPart
+	// NumberPart is a second, minute or day of the month written as a
+	// number, or the hours of a daylight saving time note.
+	NumberPart
+	// DayPart is the name of a day of the week.
+	DayPart
+	// MonthPart is the name of a month.
+	MonthPart
+	// OrdinalPart is a day of the month ("15th") or the week of a day of the
+	// week ("second" Tuesday) written as an ordinal.
+	OrdinalPart
+	// YearPart is a year.
+	YearPart
+)
+
+// String returns "text", "time", "interval", "number", "day", "month",
+// "ordinal" or "year".
+func (k PartKind) String() string {
+	switch k {
+	case TimePart:
+		return "time"
+	case IntervalPart:
+		return "interval"
+	case NumberPart:
+		return "number"
+	case DayPart:
+		return "day"
+	case MonthPart:
+		return "month"
+	case OrdinalPart:
+		return "ordinal"
+	case YearPart:
+		return "year"
+	}
+	return "text"
+}
+
+// Field is a field of a cron expression.
+type Field int
+
+const (
+	// NoField marks parts that come from no single field, such as text.
+	NoField Field = iota
+	SecondField
+	MinuteField
+	// HourField is the field of times of day, which also hold the minute
+	// and second.
+	HourField
+	DayOfMonthField
+	MonthField
+	DayOfWeekField
+	YearField
+)
+
+// String returns the field's name as in parse errors, such as "minute" or
+// "day-of-week", or "" for NoField.
+func (f Field) String() string {
+	switch f {
+	case SecondField:
+		return "second"
+	case MinuteField:
+		return "minute"
+	case HourField:
+		return "hour"
+	case DayOfMonthField:
+		return "day-of-month"
+	case MonthField:
+		return "month"
+	case DayOfWeekField:
+		return "day-of-week"
+	case YearField:
+		return "year"
+	}
+	return ""
+}
+
+// DescribePart is one segment of a description: either wording or one value
+// of the expression as the description writes it.
+type DescribePart struct {
+	Kind PartKind
+	// Text is the segment as Describe writes it, converted to the
+	// TargetLocation and translated.
+	Text string
+	// Field is the field of the expression the value comes from.
+	Field Field
+	// Values are the values of the field the segment stands for, before
+	// any time zone conversion. They are nil for text.
+	Values []int
+}
+
+// Description is a description of an expression as a list of parts, which
+// renders as plain text, HTML or Markdown.
+type Description []DescribePart
+
+// DescribeParts returns the description of the cron expression as typed
+// parts, whose texts joined are what Describe returns. If opts is nil,
+// defaults are used as for Describe.
+func (expr *Expression) DescribeParts(opts *DescribeOptions) Description {
+	if opts == nil {
+		opts = &DescribeOptions{}
+	}
+	d := newDescriber(opts)
+	var desc Description
+	if expr.interval > 0 {
+		desc = expr.describeInterval(d)
+	} else {
+		desc = d.describe(expr)
+	}
+	return desc.merged().capitalized()
+}
+
+// String returns the description as plain text.
+func (desc Description) String() string {
+	var b strings.Builder
+	for _, p := range desc {
+		b.WriteString(p.Text)
+	}
+	return b.String()
+}
+
+// HTML returns the description as HTML, with each value in a span whose
+// class is "cron-" and its kind, such as
+// <span class="cron-time" data-field="hour">9:00 AM</span>.
+func (desc Description) HTML() string {
+	var b strings.Builder
+	for _, p := range desc {
+		if p.Kind == TextPart {
+			b.WriteString(html.EscapeString(p.Text))
+			continue
+		}
+		b.WriteString(`<span class="cron-` + p.Kind.String() + `"`)
+		if p.Field != NoField {
+			b.WriteString(` data-field="` + p.Field.String() + `"`)
+		}
+		b.WriteString(">" + html.EscapeString(p.Text) + "</span>")
+	}
+	return b.String()
+}
+
+// markdownEscaper escapes the characters Markdown would read as markup.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`,
+	"[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+)
+
+// Markdown returns the description as Markdown, with the values in bold.
+func (desc Description) Markdown() string {
+	var b strings.Builder
+	for _, p := range desc {
+		text := markdownEscaper.Replace(p.Text)
+		if p.Kind == TextPart || strings.TrimSpace(text) == "" {
+			b.WriteString(text)
+		} else {
+			b.WriteString("**" + text + "**")
+		}
+	}
+	return b.String()
+}
+
+// merged returns the description with adjacent text parts joined and empty
+// ones dropped.
+func (desc Description) merged() Description {
+	var out Description
+	for _, p := range desc {
+		switch n := len(out); {
+		case p.Text == "":
+		case p.Kind == TextPart && n > 0 && out[n-1].Kind == TextPart:
+			out[n-1].Text += p.Text
+		default:
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// capitalized returns the description with its first letter upper-cased.
+func (desc Description) capitalized() Description {
+	if len(desc) > 0 {
+		desc[0].Text = descCapitalize(desc[0].Text)
+	}
+	return desc
+}
+
+// textPart returns wording as a part.
+func textPart(s string) DescribePart {
+	return DescribePart{Kind: TextPart, Text: s}
+}
+
+// valuePart returns a part of the given kind for the values of field.
+func valuePart(kind PartKind, field Field, text string, values ...int) Description {
+	return Description{{Kind: kind, Text: text, Field: field, Values: values}}
+}
+
+// numberPart returns the value v of field written as a number.
+func numberPart(field Field) func(int) Description {
+	return func(v int) Description {
+		return valuePart(NumberPart, field, strconv.Itoa(v), v)
+	}
+}
+
+// fill replaces the placeholders of a message template: {n} by the part n
+// and {1}, {2}, ... by args. Other text becomes text parts.
+func fill(tmpl string, n DescribePart, args []Description) Description {
+	var desc Description
+	for {
+		open := strings.IndexByte(tmpl, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(tmpl[open:], '}')
+		if end < 0 {
+			break
+		}
+		name := tmpl[open+1 : open+end]
+		var arg Description
+		if name == "n" {
+			arg = Description{n}
+		} else if i, err := strconv.Atoi(name); err == nil && i >= 1 && i <= len(args) {
+			arg = args[i-1]
+		} else {
+			desc = append(desc, textPart(tmpl[:open+end+1]))
+			tmpl = tmpl[open+end+1:]
+			continue
+		}
+		desc = append(desc, textPart(tmpl[:open]))
+		desc = append(desc, arg...)
+		tmpl = tmpl[open+end+1:]
+	}
+	return append(desc, textPart(tmpl))
+}
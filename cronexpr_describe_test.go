@@ -1,6 +1,12 @@
 package cronexpr_test
 
 import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,7 +34,7 @@ func TestDescribe(t *testing.T) {
 		{"twice daily", "0 12,19 * * *", "At 12:00 PM and 7:00 PM"},
 
 		// Day of week patterns
-		{"weekdays at 11pm", "0 23 * * 1-5", "At 11:00 PM, Monday–Friday"},
+		{"weekdays at 11pm", "0 23 * * 1-5", "At 11:00 PM, on weekdays"},
 		{"sunday at 9am", "0 9 * * 0", "At 9:00 AM, Sunday only"},
 		{"tue and thu at 2am", "0 2 * * 2,4", "At 2:00 AM, Tuesday and Thursday only"},
 
@@ -79,7 +85,7 @@ func TestDescribeShort(t *testing.T) {
 		targetLoc *time.Location
 		expected  string
 	}{
-		{"weekdays short names", "0 23 * * 1-5", utc, utc, "At 11PM, Mon–Fri"},
+		{"weekdays short names", "0 23 * * 1-5", utc, utc, "At 11PM, weekdays"},
 		{"specific day short", "0 9 * * 0", utc, utc, "At 9AM, Sunday only"},
 		{"multiple days short", "0 2 * * 2,4", utc, utc, "At 2AM, Tue and Thu only"},
 		{"day of month short", "0 9 1 * *", utc, utc, "At 9AM, on the 1st"},
@@ -141,3 +147,358 @@ func TestDescribeTimezone(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribe_ReferenceTime(t *testing.T) {
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Skipf("time zone data unavailable: %v", err)
+		}
+		return loc
+	}
+	berlin, ny, london, sydney := load("Europe/Berlin"), load("America/New_York"), load("Europe/London"), load("Australia/Sydney")
+	winter := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	summer := time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		src, dst *time.Location
+		ref      time.Time
+		expected string
+	}{
+		{"winter", "0 9 * * *", berlin, time.UTC, winter, "At 8:00 AM (an hour earlier for part of the year)"},
+		{"summer", "0 9 * * *", berlin, time.UTC, summer, "At 7:00 AM (an hour later for part of the year)"},
+		{"transition weeks", "0 9 * * 1-5", ny, london, winter, "At 2:00 PM, on weekdays (an hour earlier for part of the year)"},
+		{"three offsets", "0 9 * * *", sydney, london, winter, "At 10:00 PM (varies with daylight saving time)"},
+		{"entries on different days", "0 1,23 * * MON", time.UTC, ny, winter, "At 8:00 PM, Sunday only and at 6:00 PM, Monday only (an hour later for part of the year)"},
+		{"intervals unchanged", "0 */2 * * *", time.UTC, ny, winter, "At minute 0, every 2 hours"},
+		{"no daylight saving", "0 9 * * *", time.UTC, time.FixedZone("JST", 9*60*60), summer, "At 6:00 PM"},
+		{"last day to next day", "0 23 L * *", ny, load("Asia/Tokyo"), winter, "At 11:00 PM, on the last day of the month (America/New_York time)"},
+		{"1st to previous day", "0 0 1 * *", berlin, ny, winter, "At 12:00 AM, on the 1st of the month (Europe/Berlin time)"},
+		{"nth weekday to previous day", "0 0 * * 1#2", berlin, ny, winter, "At 12:00 AM, on the second Monday of the month (Europe/Berlin time)"},
+		{"hourly on the 1st", "0 * 1 * *", berlin, ny, winter, "At minute 0, every hour, on the 1st of the month (Europe/Berlin time)"},
+		{"day of month on the same day", "0 12 1 * *", berlin, ny, winter, "At 6:00 AM, on the 1st of the month (an hour later for part of the year)"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := cronexpr.MustParse(tc.expr).Describe(&cronexpr.DescribeOptions{
+				SourceLocation: tc.src, TargetLocation: tc.dst, ReferenceTime: tc.ref,
+			})
+			if got != tc.expected {
+				t.Errorf("Describe(%q, %v→%v) = %q, want %q", tc.expr, tc.src, tc.dst, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDescribe_ParsedFields(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		// A sixth field is the year, not leading seconds
+		{"0 9 * * 1 2025", "At 9:00 AM, Monday only, only in 2025"},
+		{"30 0 9 * * 1 *", "At 9:00:30 AM, Monday only"},
+		// Names and literal lists describe like their numeric forms
+		{"0 9 * JAN-MAR MON", "At 9:00 AM, Monday only in January–March"},
+		{"0,20,40 * * * *", "Every 20 minutes"},
+		{"0 0,6,12,18 * * *", "At minute 0, every 6 hours"},
+		{"0 9 * * 7", "At 9:00 AM, Sunday only"},
+		{"0,30 * * * *", "Every 30 minutes"},
+		{"5,35 * * * *", "At minutes 5 and 35, every hour"},
+		{"*/15 9,17 * * *", "Every 15 minutes, during the 9:00 AM and 5:00 PM hours"},
+		{"* 9 * * *", "Every minute, during the 9:00 AM hour"},
+		// L, W and # entries
+		{"0 0 LW * *", "At 12:00 AM, on the last business day of the month"},
+		{"0 0 1,15,L * *", "At 12:00 AM, on the 1st, 15th, and last day of the month"},
+		{"0 9 * * 2#3,4#3", "At 9:00 AM, on the third Tuesday and third Thursday of the month"},
+		{"0 9 * * 1-5,5L", "At 9:00 AM, on weekdays and on the last Friday of the month"},
+	}
+	for _, tc := range tests {
+		if got := cronexpr.MustParse(tc.expr).Describe(nil); got != tc.expected {
+			t.Errorf("Describe(%q) = %q, want %q", tc.expr, got, tc.expected)
+		}
+	}
+}
+
+func TestDescribe_TimeFormat(t *testing.T) {
+	utc := time.UTC
+	mst := time.FixedZone("MST", -7*60*60)
+	tests := []struct {
+		expr     string
+		opts     cronexpr.DescribeOptions
+		expected string
+	}{
+		{"0 15 * * *", cronexpr.DescribeOptions{Hour24: true}, "At 15:00"},
+		{"30 9,21 * * *", cronexpr.DescribeOptions{Hour24: true}, "Twice daily at 9:30 and 21:30"},
+		{"0 9-17 * * *", cronexpr.DescribeOptions{Hour24: true}, "At minute 0, 9:00–17:00"},
+		{"0 9-17 * * *", cronexpr.DescribeOptions{Hour24: true, Short: true}, "At min 0, 9:00–17:00"},
+		{"0 2 * * 2,4", cronexpr.DescribeOptions{Hour24: true, SourceLocation: utc, TargetLocation: mst}, "At 19:00, Monday and Wednesday only"},
+		{"0 15 * * *", cronexpr.DescribeOptions{TimeFormat: "15h04"}, "At 15h00"},
+		{"0 9,17 * * *", cronexpr.DescribeOptions{TimeFormat: "3:04pm"}, "At 9:00am and 5:00pm"},
+		{"*/20 7-20 * * *", cronexpr.DescribeOptions{TimeFormat: "15.04"}, "Every 20 minutes, 07.00–20.00"},
+		{"30 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true}, "At 9:00:30 AM"},
+		{"30 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true, Short: true}, "At 9:00:30AM"},
+		{"30 0 9,21 * * * *", cronexpr.DescribeOptions{Seconds: true, Hour24: true}, "Twice daily at 9:00:30 and 21:00:30"},
+		{"30 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true, TimeFormat: "15:04:05"}, "At 09:00:30"},
+		{"30 0 9-17 * * * *", cronexpr.DescribeOptions{Seconds: true, Hour24: true}, "At second 30, at minute 0, 9:00–17:00"},
+		{"0 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true}, "At 9:00:00 AM"},
+		{"0 0 9 * * * *", cronexpr.DescribeOptions{}, "At 9:00 AM"},
+		{"30 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true, Locale: "de"}, "Um 9:00:30 Uhr"},
+		{"30 0 9 * * * *", cronexpr.DescribeOptions{Seconds: true, Locale: "ja", Short: true}, "9時0分30秒"},
+	}
+	for _, tc := range tests {
+		if got := cronexpr.MustParse(tc.expr).Describe(&tc.opts); got != tc.expected {
+			t.Errorf("Describe(%q, %+v) = %q, want %q", tc.expr, tc.opts, got, tc.expected)
+		}
+	}
+}
+
+func TestDescribe_SecondsAndYears(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"* * * * * * *", "Every second"},
+		{"*/10 * * * * * *", "Every 10 seconds"},
+		{"30 * * * * * *", "At second 30 of every minute"},
+		{"5,35 * * * * * *", "At seconds 5 and 35 of every minute"},
+		{"30 0 9 * * * *", "At 9:00:30 AM"},
+		{"15 30 9,21 * * * *", "Twice daily at 9:30:15 AM and 9:30:15 PM"},
+		{"*/10 0 9 * * * *", "Every 10 seconds, at 9:00 AM"},
+		{"*/10 * 9-17 * * * *", "Every 10 seconds, 9:00 AM–5:00 PM"},
+		{"30 * 9 * * * *", "At second 30, during the 9:00 AM hour"},
+		{"*/10 5 * * * * *", "Every 10 seconds, at minute 5, every hour"},
+		{"0 0 12 * * * 2026", "At 12:00 PM, only in 2026"},
+		{"0 0 12 * * * 2025-2027", "At 12:00 PM, in 2025–2027"},
+		{"0 0 0 1 1 * 2024/2", "At 12:00 AM, on the 1st of the month only in January, every 2 years from 2024"},
+		{"0 0 9 * * 1 2025,2027", "At 9:00 AM, Monday only, in 2025 and 2027"},
+	}
+	for _, tc := range tests {
+		if got := cronexpr.MustParse(tc.expr).Describe(nil); got != tc.expected {
+			t.Errorf("Describe(%q) = %q, want %q", tc.expr, got, tc.expected)
+		}
+	}
+	if got, want := cronexpr.MustParse("*/10 * * * * * *").Describe(&cronexpr.DescribeOptions{Short: true}), "Every 10 secs"; got != want {
+		t.Errorf("Describe(short) = %q, want %q", got, want)
+	}
+}
+
+func TestDescribe_Combinations(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"5-20/3 * * * *", "Every 3 minutes from minute 5 through 20, every hour"},
+		{"10/15 * * * *", "Every 15 minutes from minute 10 through 55, every hour"},
+		{"0-5,30 * * * *", "At minutes 0–5 and 30, every hour"},
+		{"0 22-3 * * *", "At minute 0, 10:00 PM–3:00 AM"},
+		{"*/15 9-17/2 * * *", "Every 15 minutes, every 2 hours from 9:00 AM through 5:00 PM"},
+		{"*/5 9-11,14-16 * * *", "Every 5 minutes, during the 9:00 AM–11:00 AM and 2:00 PM–4:00 PM hours"},
+		{"0 0 1,15-20,L * *", "At 12:00 AM, on the 1st, 15th–20th, and last day of the month"},
+		{"0 0 25-5 * *", "At 12:00 AM, on the 1st–5th and 25th–31st of the month"},
+		{"0 0 1-20/5 * *", "At 12:00 AM, every 5 days from the 1st through the 16th"},
+		{"0 9 * * MON-WED,FRI", "At 9:00 AM, Monday–Wednesday and Friday only"},
+		{"0 9 * * FRI-MON", "At 9:00 AM, Friday–Monday"},
+		{"0 9 * * SAT,SUN", "At 9:00 AM, on weekends"},
+		{"0 0 * JAN-MAR,OCT *", "At 12:00 AM, in January–March and October"},
+		{"5-55/10 * * * * * *", "Every 10 seconds from second 5 through 55 of every minute"},
+		// Parse accepts values past a field's range: Next carries hours over
+		// into the next day and runs on no day 0.
+		{"0 25 * * *", "At 1:00 AM"},
+		{"0 23-25 * * *", "At minute 0, 11:00 PM–1:00 AM"},
+		{"0 9 0,15 * *", "At 9:00 AM, on the 0th and 15th of the month"},
+	}
+	for _, tc := range tests {
+		if got := cronexpr.MustParse(tc.expr).Describe(nil); got != tc.expected {
+			t.Errorf("Describe(%q) = %q, want %q", tc.expr, got, tc.expected)
+		}
+	}
+}
+
+func TestDescribe_Idioms(t *testing.T) {
+	tests := []struct {
+		expr    string
+		idiom   string
+		literal string
+	}{
+		{"0 9 * * 1-5", "At 9:00 AM, on weekdays", "At 9:00 AM, Monday–Friday"},
+		{"0 10 * * SAT,SUN", "At 10:00 AM, on weekends", "At 10:00 AM, Sunday and Saturday only"},
+		{"*/15 9-17 * * 1-5", "Every 15 minutes, during business hours", "Every 15 minutes, 9:00 AM–5:00 PM, Monday–Friday"},
+		{"0 9-17 * 1 1-5", "At minute 0, during business hours, in January", "At minute 0, 9:00 AM–5:00 PM, Monday–Friday in January"},
+		{"*/15 9-16 * * 1-5", "Every 15 minutes, 9:00 AM–4:00 PM, on weekdays", "Every 15 minutes, 9:00 AM–4:00 PM, Monday–Friday"},
+		{"0 0 1 1,4,7,10 *", "At 12:00 AM, quarterly on the 1st of the month", "At 12:00 AM, on the 1st of the month in January, April, July, and October"},
+		{"0 0 L 1,7 *", "At 12:00 AM, semiannually on the last day of the month", "At 12:00 AM, on the last day of the month in January and July"},
+		{"0 0 1,15 1,4,7,10 *", "At 12:00 AM, on the 1st and 15th of the month in January, April, July, and October", "At 12:00 AM, on the 1st and 15th of the month in January, April, July, and October"},
+		{"0 */12 * * *", "Twice daily at 12:00 AM and 12:00 PM", "At minute 0, every 12 hours"},
+		{"30 6,18 * * *", "Twice daily at 6:30 AM and 6:30 PM", "At 6:30 AM and 6:30 PM"},
+		{"0 9,17 * * *", "At 9:00 AM and 5:00 PM", "At 9:00 AM and 5:00 PM"},
+		{"0 6,18 * * 1", "At 6:00 AM and 6:00 PM, Monday only", "At 6:00 AM and 6:00 PM, Monday only"},
+		{"0 3 */2 * *", "At 3:00 AM, every other day of the month", "At 3:00 AM, every 2 days"},
+		{"0 9 1W * *", "At 9:00 AM, on the first business day of the month", "At 9:00 AM, on the weekday nearest the 1st of the month"},
+		{"0 17 LW * *", "At 5:00 PM, on the last business day of the month", "At 5:00 PM, on the last weekday of the month"},
+	}
+	for _, tt := range tests {
+		expr := cronexpr.MustParse(tt.expr)
+		if got := expr.Describe(nil); got != tt.idiom {
+			t.Errorf("Describe(%q) = %q, want %q", tt.expr, got, tt.idiom)
+		}
+		if got := expr.Describe(&cronexpr.DescribeOptions{Literal: true}); got != tt.literal {
+			t.Errorf("Describe(%q, literal) = %q, want %q", tt.expr, got, tt.literal)
+		}
+	}
+
+	// Idioms describe the days in the target location: 11 PM Monday–Friday
+	// in New York is Tuesday–Saturday in UTC.
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	opts := &cronexpr.DescribeOptions{SourceLocation: ny, ReferenceTime: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)}
+	if got, want := cronexpr.MustParse("0 23 * * 1-5").Describe(opts), "At 4:00 AM, Tuesday–Saturday (an hour earlier for part of the year)"; got != want {
+		t.Errorf("Describe(0 23 * * 1-5, New York) = %q, want %q", got, want)
+	}
+	opts = &cronexpr.DescribeOptions{Phrases: map[string]string{"idiom-weekdays": ""}}
+	if got, want := cronexpr.MustParse("0 9 * * 1-5").Describe(opts), "At 9:00 AM, Monday–Friday"; got != want {
+		t.Errorf("Describe with an empty idiom = %q, want %q", got, want)
+	}
+}
+
+func TestDescribeParts(t *testing.T) {
+	text := func(s string) cronexpr.DescribePart {
+		return cronexpr.DescribePart{Kind: cronexpr.TextPart, Text: s}
+	}
+	parts := cronexpr.MustParse("*/15 9-17 * * 1#2").DescribeParts(nil)
+	want := cronexpr.Description{
+		text("Every "),
+		{Kind: cronexpr.IntervalPart, Text: "15", Field: cronexpr.MinuteField, Values: []int{15}},
+		text(" minutes, "),
+		{Kind: cronexpr.TimePart, Text: "9:00 AM", Field: cronexpr.HourField, Values: []int{9, 0, 0}},
+		text("–"),
+		{Kind: cronexpr.TimePart, Text: "5:00 PM", Field: cronexpr.HourField, Values: []int{17, 0, 0}},
+		text(", on the "),
+		{Kind: cronexpr.OrdinalPart, Text: "second", Field: cronexpr.DayOfWeekField, Values: []int{2}},
+		text(" "),
+		{Kind: cronexpr.DayPart, Text: "Monday", Field: cronexpr.DayOfWeekField, Values: []int{1}},
+		text(" of the month"),
+	}
+	if !reflect.DeepEqual(parts, want) {
+		t.Errorf("DescribeParts = %#v, want %#v", parts, want)
+	}
+
+	expr := cronexpr.MustParse("0 9 1,L * MON-THU")
+	if got, want := expr.DescribeParts(nil).String(), expr.Describe(nil); got != want {
+		t.Errorf("DescribeParts.String = %q, want %q", got, want)
+	}
+	if got, want := expr.DescribeParts(nil).HTML(), `At <span class="cron-time" data-field="hour">9:00 AM</span>, on the <span class="cron-ordinal" data-field="day-of-month">1st</span> and last day of the month and <span class="cron-day" data-field="day-of-week">Monday</span>–<span class="cron-day" data-field="day-of-week">Thursday</span>`; got != want {
+		t.Errorf("HTML = %q, want %q", got, want)
+	}
+	if got, want := expr.DescribeParts(nil).Markdown(), "At **9:00 AM**, on the **1st** and last day of the month and **Monday**–**Thursday**"; got != want {
+		t.Errorf("Markdown = %q, want %q", got, want)
+	}
+
+	rate, err := cronexpr.ParseEventBridge("rate(5 minutes)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rate.DescribeParts(nil)[1], (cronexpr.DescribePart{Kind: cronexpr.IntervalPart, Text: "5", Field: cronexpr.MinuteField, Values: []int{5}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("DescribeParts(rate(5 minutes))[1] = %#v, want %#v", got, want)
+	}
+}
+
+// TestDescribe_Generated describes random expressions built from every
+// construct Parse accepts, in every built-in language, and checks that no
+// description is empty or has leftover placeholders or empty list items,
+// and that ParseNatural reads the English descriptions back into schedules
+// with the same runs.
+func TestDescribe_Generated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bad := []string{"{", "}", "%!", "  ", " ,", ",,", "、、", "–,", "–、", " 0th", "only only"}
+	for range 1000 {
+		expr := genExpression(r)
+		e, err := cronexpr.Parse(expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		for _, locale := range []string{"en", "de", "fr", "es", "ja"} {
+			for _, short := range []bool{false, true} {
+				parts := e.DescribeParts(&cronexpr.DescribeOptions{Locale: locale, Short: short})
+				for _, p := range parts {
+					if p.Kind != cronexpr.TextPart && (p.Field == cronexpr.NoField || len(p.Values) == 0) {
+						t.Fatalf("DescribeParts(%q, %s, short=%v) has %v part %q without a field or values", expr, locale, short, p.Kind, p.Text)
+					}
+				}
+				desc := parts.String()
+				if desc == "" || strings.TrimSpace(desc) != desc {
+					t.Fatalf("Describe(%q, %s, short=%v) = %q", expr, locale, short, desc)
+				}
+				for _, b := range bad {
+					if strings.Contains(desc, b) {
+						t.Fatalf("Describe(%q, %s, short=%v) = %q, contains %q", expr, locale, short, desc, b)
+					}
+				}
+				if locale != "en" {
+					continue
+				}
+				got, err := cronexpr.ParseNatural(desc)
+				if err != nil {
+					t.Fatalf("Describe(%q, short=%v) = %q: %v", expr, short, desc, err)
+				}
+				if want := e.NextN(from, 20); !slices.Equal(got.NextN(from, 20), want) {
+					t.Fatalf("Describe(%q, short=%v) = %q, which runs at %v, want %v", expr, short, desc, got.NextN(from, 3), want[:min(3, len(want))])
+				}
+			}
+		}
+	}
+}
+
+// genExpression returns a random cron expression of five, six or seven
+// fields.
+func genExpression(r *rand.Rand) string {
+	fields := []string{
+		genField(r, 0, 59, nil),
+		genField(r, 0, 23, nil),
+		genField(r, 1, 31, []string{"L", "LW", strconv.Itoa(1+r.IntN(31)) + "W"}),
+		genField(r, 1, 12, []string{"JAN", "MAR-MAY", "NOV-FEB"}),
+		genField(r, 0, 6, []string{"MON-FRI", "FRI-MON", strconv.Itoa(r.IntN(7)) + "L", fmt.Sprintf("%d#%d", r.IntN(7), 1+r.IntN(5))}),
+	}
+	switch r.IntN(3) {
+	case 1:
+		fields = append(fields, genField(r, 2020, 2040, nil))
+	case 2:
+		fields = append([]string{genField(r, 0, 59, nil)}, append(fields, genField(r, 2020, 2040, nil))...)
+	}
+	return strings.Join(fields, " ")
+}
+
+// genField returns a random field of values lo-hi: a list of one to three
+// entries that are each *, a value, a range (wrapping when reversed), a
+// step, or one of the extra entries.
+func genField(r *rand.Rand, lo, hi int, extra []string) string {
+	if r.IntN(3) == 0 {
+		return "*"
+	}
+	value := func() int { return lo + r.IntN(hi-lo+1) }
+	entries := make([]string, 1+r.IntN(3))
+	for i := range entries {
+		switch k := r.IntN(6); {
+		case k == 0:
+			entries[i] = strconv.Itoa(value())
+		case k == 1:
+			entries[i] = fmt.Sprintf("%d-%d", value(), value())
+		case k == 2:
+			entries[i] = fmt.Sprintf("%d-%d/%d", value(), value(), 1+r.IntN(min(hi, 15)))
+		case k == 3:
+			entries[i] = fmt.Sprintf("*/%d", 1+r.IntN(min(hi, 15)))
+		case k == 4:
+			entries[i] = fmt.Sprintf("%d/%d", value(), 1+r.IntN(min(hi, 15)))
+		case len(extra) > 0:
+			entries[i] = extra[r.IntN(len(extra))]
+		default:
+			entries[i] = strconv.Itoa(value())
+		}
+	}
+	return strings.Join(entries, ",")
+}
@@ -25,26 +25,24 @@ func (expr *Expression) nextYear(t time.Time) time.Time {
 	if i == len(expr.yearList) {
 		return time.Time{}
 	}
-	expr.actualDaysOfMonthList = expr.calculateActualDaysOfMonth(expr.yearList[i], expr.monthList[0])
-	if len(expr.actualDaysOfMonthList) == 0 {
-		return expr.nextMonth(time.Date(
+	days := expr.calculateActualDaysOfMonth(expr.yearList[i], expr.monthList[0])
+	if len(days) == 0 {
+		return expr.nextMonth(wallDate(
 			expr.yearList[i],
 			time.Month(expr.monthList[0]),
 			1,
 			expr.hourList[0],
 			expr.minuteList[0],
 			expr.secondList[0],
-			0,
 			t.Location()))
 	}
-	return time.Date(
+	return wallDate(
 		expr.yearList[i],
 		time.Month(expr.monthList[0]),
-		expr.actualDaysOfMonthList[0],
+		days[0],
 		expr.hourList[0],
 		expr.minuteList[0],
 		expr.secondList[0],
-		0,
 		t.Location())
 }
 
@@ -55,102 +53,96 @@ func (expr *Expression) nextMonth(t time.Time) time.Time {
 	if i == len(expr.monthList) {
 		return expr.nextYear(t)
 	}
-	expr.actualDaysOfMonthList = expr.calculateActualDaysOfMonth(t.Year(), expr.monthList[i])
-	if len(expr.actualDaysOfMonthList) == 0 {
-		return expr.nextMonth(time.Date(
+	days := expr.calculateActualDaysOfMonth(t.Year(), expr.monthList[i])
+	if len(days) == 0 {
+		return expr.nextMonth(wallDate(
 			t.Year(),
 			time.Month(expr.monthList[i]),
 			1,
 			expr.hourList[0],
 			expr.minuteList[0],
 			expr.secondList[0],
-			0,
 			t.Location()))
 	}
 
-	return time.Date(
+	return wallDate(
 		t.Year(),
 		time.Month(expr.monthList[i]),
-		expr.actualDaysOfMonthList[0],
+		days[0],
 		expr.hourList[0],
 		expr.minuteList[0],
 		expr.secondList[0],
-		0,
 		t.Location())
 }
 
 // nextDayOfMonth advances to the next eligible day within the current month,
 // cascading to nextMonth if no remaining days match.
-func (expr *Expression) nextDayOfMonth(t time.Time) time.Time {
-	i, _ := slices.BinarySearch(expr.actualDaysOfMonthList, t.Day()+1)
-	if i == len(expr.actualDaysOfMonthList) {
+func (expr *Expression) nextDayOfMonth(t time.Time, days []int) time.Time {
+	i, _ := slices.BinarySearch(days, t.Day()+1)
+	if i == len(days) {
 		return expr.nextMonth(t)
 	}
 
-	return time.Date(
+	return wallDate(
 		t.Year(),
 		t.Month(),
-		expr.actualDaysOfMonthList[i],
+		days[i],
 		expr.hourList[0],
 		expr.minuteList[0],
 		expr.secondList[0],
-		0,
 		t.Location())
 }
 
 // nextHour advances to the next eligible hour within the current day,
 // cascading to nextDayOfMonth if no remaining hours match.
-func (expr *Expression) nextHour(t time.Time) time.Time {
+func (expr *Expression) nextHour(t time.Time, days []int) time.Time {
 	i, _ := slices.BinarySearch(expr.hourList, t.Hour()+1)
 	if i == len(expr.hourList) {
-		return expr.nextDayOfMonth(t)
+		return expr.nextDayOfMonth(t, days)
 	}
 
-	return time.Date(
+	return wallDate(
 		t.Year(),
 		t.Month(),
 		t.Day(),
 		expr.hourList[i],
 		expr.minuteList[0],
 		expr.secondList[0],
-		0,
 		t.Location())
 }
 
 // nextMinute advances to the next eligible minute within the current hour,
 // cascading to nextHour if no remaining minutes match.
-func (expr *Expression) nextMinute(t time.Time) time.Time {
+func (expr *Expression) nextMinute(t time.Time, days []int) time.Time {
 	i, _ := slices.BinarySearch(expr.minuteList, t.Minute()+1)
 	if i == len(expr.minuteList) {
-		return expr.nextHour(t)
+		return expr.nextHour(t, days)
 	}
 
-	return time.Date(
+	return wallDate(
 		t.Year(),
 		t.Month(),
 		t.Day(),
 		t.Hour(),
 		expr.minuteList[i],
 		expr.secondList[0],
-		0,
 		t.Location())
 }
 
 // nextSecond assumes all other fields already match the cron expression.
-func (expr *Expression) nextSecond(t time.Time) time.Time {
+func (expr *Expression) nextSecond(t time.Time, days []int) time.Time {
 	i, _ := slices.BinarySearch(expr.secondList, t.Second()+1)
 	if i == len(expr.secondList) {
-		return expr.nextMinute(t)
+		return expr.nextMinute(t, days)
 	}
 
-	return time.Date(
+	return wallDate(
 		t.Year(),
 		t.Month(),
 		t.Day(),
 		t.Hour(),
 		t.Minute(),
 		expr.secondList[i],
-		0,
 		t.Location())
 }
 
@@ -174,14 +166,15 @@ func (expr *Expression) calculateActualDaysOfMonth(year, month int) []int {
 		if expr.lastWorkdayOfMonth {
 			actualDaysOfMonthMap[workdayOfMonth(lastDayOfMonth, lastDayOfMonth)] = true
 		}
+		// Day 0, which Parse accepts, is in no month.
 		for v := range expr.daysOfMonth {
-			if v <= lastDayOfMonth.Day() {
+			if v >= 1 && v <= lastDayOfMonth.Day() {
 				actualDaysOfMonthMap[v] = true
 			}
 		}
 		// W (nearest weekday) does not cross month boundaries.
 		for v := range expr.workdaysOfMonth {
-			if v <= lastDayOfMonth.Day() {
+			if v >= 1 && v <= lastDayOfMonth.Day() {
 				actualDaysOfMonthMap[workdayOfMonth(firstDayOfMonth.AddDate(0, 0, v-1), lastDayOfMonth)] = true
 			}
 		}
@@ -216,6 +209,43 @@ func (expr *Expression) calculateActualDaysOfMonth(year, month int) []int {
 	return toList(actualDaysOfMonthMap)
 }
 
+// nextByClock returns the next instant after fromTime whose wall-clock time
+// matches, following the clock through daylight saving transitions. Within
+// each span of one UTC offset, the fields are matched on wall-clock times in
+// UTC.
+func (expr *Expression) nextByClock(fromTime time.Time) time.Time {
+	from, at := fromTime, fromTime
+	for {
+		_, offset := at.Zone()
+		_, end := at.ZoneBounds()
+		shift := time.Duration(offset) * time.Second
+		wall := expr.next(from.UTC().Add(shift))
+		if wall.IsZero() {
+			return wall
+		}
+		t := wall.Add(-shift)
+		if end.IsZero() || t.Before(end) {
+			return t.In(fromTime.Location())
+		}
+		// The run falls after the offset changes; look again from there.
+		from, at = end.Add(-time.Nanosecond), end
+	}
+}
+
+// wallDate returns the instant for the given wall-clock time in loc. If the
+// time falls in a gap skipped by a daylight saving transition, time.Date
+// normalizes it to before the gap; wallDate instead moves it forward by the
+// size of the gap, so a skipped run happens once the clocks have jumped.
+func wallDate(year int, month time.Month, day, hour, minute, second int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, minute, second, 0, loc)
+	if t.Hour() == hour && t.Minute() == minute {
+		return t
+	}
+	_, offset := t.Zone()
+	wall := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	return wall.Add(-time.Duration(offset) * time.Second).In(loc)
+}
+
 // workdayOfMonth returns the nearest weekday to targetDom that does not cross
 // the month boundary defined by lastDom.
 func workdayOfMonth(targetDom, lastDom time.Time) int {
@@ -138,6 +138,20 @@ var (
 	}
 )
 
+// entryError is a field error located at one comma-separated entry of the
+// field. Parse turns it into a ParseError pointing at that entry.
+type entryError struct {
+	msg        string
+	start, end int
+}
+
+func (e *entryError) Error() string { return e.msg }
+
+// newEntryError returns an entryError for the entry of directive.
+func newEntryError(directive *cronDirective, format string, args ...any) *entryError {
+	return &entryError{msg: fmt.Sprintf(format, args...), start: directive.sbeg, end: directive.send}
+}
+
 // entrySpan represents a comma-separated entry within a cron field,
 // tracking its text and position within the original field string.
 type entrySpan struct {
@@ -161,6 +175,24 @@ func splitEntries(s string) []entrySpan {
 	return spans
 }
 
+// splitFields splits a cron expression on whitespace, returning each field
+// with its position in the original string.
+func splitFields(s string) []entrySpan {
+	var spans []entrySpan
+	start := -1
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || strings.IndexByte(" \t\n\v\f\r", s[i]) >= 0 {
+			if start >= 0 {
+				spans = append(spans, entrySpan{s[start:i], start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return spans
+}
+
 // cronNormalizer expands predefined cron aliases into 7-field expressions.
 var cronNormalizer = strings.NewReplacer(
 	"@yearly", "0 0 0 1 1 * *",
@@ -206,7 +238,7 @@ func genericFieldHandler(s string, desc fieldDescriptor) ([]int, error) {
 	for _, directive := range directives {
 		switch directive.kind {
 		case none:
-			return nil, fmt.Errorf("syntax error in %s field: '%s'", desc.name, s[directive.sbeg:directive.send])
+			return nil, newEntryError(directive, "syntax error in %s field: '%s'", desc.name, s[directive.sbeg:directive.send])
 		case one:
 			populateOne(values, directive.first)
 		case span:
@@ -255,7 +287,7 @@ func (expr *Expression) dowFieldHandler(s string) error {
 					continue
 				}
 			}
-			return fmt.Errorf("syntax error in day-of-week field: '%s'", sdirective)
+			return newEntryError(directive, "syntax error in day-of-week field: '%s'", sdirective)
 		case one:
 			populateOne(expr.daysOfWeek, directive.first)
 		case span:
@@ -297,10 +329,10 @@ func (expr *Expression) domFieldHandler(s string) error {
 				if dom, ok := domDescriptor.atoi(prefix); ok {
 					populateOne(expr.workdaysOfMonth, dom)
 				} else {
-					return fmt.Errorf("syntax error in day-of-month field: '%s'", sdirective)
+					return newEntryError(directive, "syntax error in day-of-month field: '%s'", sdirective)
 				}
 			default:
-				return fmt.Errorf("syntax error in day-of-month field: '%s'", sdirective)
+				return newEntryError(directive, "syntax error in day-of-month field: '%s'", sdirective)
 			}
 		case one:
 			populateOne(expr.daysOfMonth, directive.first)
@@ -391,7 +423,7 @@ func genericFieldParse(s string, desc fieldDescriptor) ([]*cronDirective, error)
 				continue
 			}
 			if err := validateStep(step, desc.max, snormal); err != nil {
-				return nil, err
+				return nil, &entryError{msg: err.Error(), start: entry.start, end: entry.end}
 			}
 
 			if base == "*" {
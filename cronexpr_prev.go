@@ -0,0 +1,173 @@
+package cronexpr
+
+import (
+	"slices"
+	"time"
+)
+
+// The prev* helpers walk the fields backwards on wall-clock times expressed
+// in UTC, which has no daylight saving gaps. Prev converts the result to the
+// caller's location.
+
+// prev returns the latest wall-clock time strictly before wall that matches
+// the expression, or the zero time if there is none.
+func (expr *Expression) prev(wall time.Time) time.Time {
+	// year
+	if _, ok := slices.BinarySearch(expr.yearList, wall.Year()); !ok {
+		return expr.prevMonth(wall.Year(), 1)
+	}
+	// month
+	if _, ok := slices.BinarySearch(expr.monthList, int(wall.Month())); !ok {
+		return expr.prevMonth(wall.Year(), int(wall.Month()))
+	}
+	days := expr.calculateActualDaysOfMonth(wall.Year(), int(wall.Month()))
+	// day of month
+	if _, ok := slices.BinarySearch(days, wall.Day()); !ok {
+		return expr.prevDayOfMonth(wall, days)
+	}
+	// hour
+	if _, ok := slices.BinarySearch(expr.hourList, wall.Hour()); !ok {
+		return expr.prevHour(wall, days)
+	}
+	// minute
+	if _, ok := slices.BinarySearch(expr.minuteList, wall.Minute()); !ok {
+		return expr.prevMinute(wall, days)
+	}
+	// second
+	return expr.prevSecond(wall, days)
+}
+
+// prevMonth returns the last matching time in the latest eligible month
+// before the given year and month, stepping back through the years as needed.
+func (expr *Expression) prevMonth(year, month int) time.Time {
+	for {
+		i, _ := slices.BinarySearch(expr.monthList, month)
+		if i == 0 {
+			j, _ := slices.BinarySearch(expr.yearList, year)
+			if j == 0 {
+				return time.Time{}
+			}
+			year = expr.yearList[j-1]
+			month = expr.monthList[len(expr.monthList)-1] + 1
+			continue
+		}
+		month = expr.monthList[i-1]
+		days := expr.calculateActualDaysOfMonth(year, month)
+		if len(days) == 0 {
+			continue
+		}
+		return time.Date(
+			year,
+			time.Month(month),
+			days[len(days)-1],
+			expr.hourList[len(expr.hourList)-1],
+			expr.minuteList[len(expr.minuteList)-1],
+			expr.secondList[len(expr.secondList)-1],
+			0,
+			time.UTC)
+	}
+}
+
+// prevDayOfMonth steps back to the previous eligible day within the current
+// month, whose matching days are given by days, cascading to prevMonth if no
+// earlier days match.
+func (expr *Expression) prevDayOfMonth(t time.Time, days []int) time.Time {
+	i, _ := slices.BinarySearch(days, t.Day())
+	if i == 0 {
+		return expr.prevMonth(t.Year(), int(t.Month()))
+	}
+
+	return time.Date(
+		t.Year(),
+		t.Month(),
+		days[i-1],
+		expr.hourList[len(expr.hourList)-1],
+		expr.minuteList[len(expr.minuteList)-1],
+		expr.secondList[len(expr.secondList)-1],
+		0,
+		time.UTC)
+}
+
+// prevHour steps back to the previous eligible hour within the current day,
+// cascading to prevDayOfMonth if no earlier hours match.
+func (expr *Expression) prevHour(t time.Time, days []int) time.Time {
+	i, _ := slices.BinarySearch(expr.hourList, t.Hour())
+	if i == 0 {
+		return expr.prevDayOfMonth(t, days)
+	}
+
+	return time.Date(
+		t.Year(),
+		t.Month(),
+		t.Day(),
+		expr.hourList[i-1],
+		expr.minuteList[len(expr.minuteList)-1],
+		expr.secondList[len(expr.secondList)-1],
+		0,
+		time.UTC)
+}
+
+// prevMinute steps back to the previous eligible minute within the current
+// hour, cascading to prevHour if no earlier minutes match.
+func (expr *Expression) prevMinute(t time.Time, days []int) time.Time {
+	i, _ := slices.BinarySearch(expr.minuteList, t.Minute())
+	if i == 0 {
+		return expr.prevHour(t, days)
+	}
+
+	return time.Date(
+		t.Year(),
+		t.Month(),
+		t.Day(),
+		t.Hour(),
+		expr.minuteList[i-1],
+		expr.secondList[len(expr.secondList)-1],
+		0,
+		time.UTC)
+}
+
+// prevSecond steps back to the previous eligible second within the current
+// minute, cascading to prevMinute if no earlier seconds match.
+func (expr *Expression) prevSecond(t time.Time, days []int) time.Time {
+	i, _ := slices.BinarySearch(expr.secondList, t.Second())
+	if i == 0 {
+		return expr.prevMinute(t, days)
+	}
+
+	return time.Date(
+		t.Year(),
+		t.Month(),
+		t.Day(),
+		t.Hour(),
+		t.Minute(),
+		expr.secondList[i-1],
+		0,
+		time.UTC)
+}
+
+// prevByClock returns the latest instant before fromTime whose wall-clock
+// time matches, following the clock through daylight saving transitions as
+// nextByClock does.
+func (expr *Expression) prevByClock(fromTime time.Time) time.Time {
+	from, at := fromTime, fromTime
+	for {
+		_, offset := at.Zone()
+		start, _ := at.ZoneBounds()
+		shift := time.Duration(offset) * time.Second
+		wall := from.UTC().Add(shift)
+		if wall.Nanosecond() > 0 {
+			// The current second itself precedes fromTime.
+			wall = wall.Truncate(time.Second).Add(time.Second)
+		}
+		wall = expr.prev(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		t := wall.Add(-shift)
+		if start.IsZero() || !t.Before(start) {
+			return t.In(fromTime.Location())
+		}
+		// The run falls before the offset changed; look again from there.
+		from, at = start, start.Add(-time.Nanosecond)
+	}
+}
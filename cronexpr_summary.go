@@ -0,0 +1,81 @@
+package cronexpr
+
+import (
+	"strconv"
+	"time"
+)
+
+// Summary returns the description of the cron expression with its next and
+// last runs around now, relative and in the TargetLocation, such as
+// "At 9:00 AM, on weekdays — next run in 3h 12m (Tue 9:00 AM CEST), last
+// run 2 days ago (Fri 9:00 AM CEST)". Runs are found with Next and Prev from
+// now in the SourceLocation; when Next finds none, the summary says "no
+// further runs". If opts is nil, defaults are used as for Describe, and a
+// zero ReferenceTime is now.
+func (expr *Expression) Summary(now time.Time, opts *DescribeOptions) string {
+	o := DescribeOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.ReferenceTime.IsZero() {
+		o.ReferenceTime = now
+	}
+	d := newDescriber(&o)
+	desc := expr.DescribeParts(&o)
+	now = now.In(d.src)
+
+	next := d.phrase("no-further-runs", 0)
+	if t := expr.Next(now); !t.IsZero() {
+		next = d.phrase("next-run", 0, d.duration(t.Sub(now)), d.runTime(t, now))
+	}
+	if t := expr.Prev(now); !t.IsZero() {
+		return d.phrase("summary-last", 0, desc, next, d.phrase("last-run", 0, d.duration(now.Sub(t)), d.runTime(t, now))).String()
+	}
+	return d.phrase("summary", 0, desc, next).String()
+}
+
+// duration formats the time to or since a run: seconds under a minute,
+// minutes under an hour, hours and minutes under a day, and whole days
+// beyond.
+func (d *describer) duration(dur time.Duration) Description {
+	switch {
+	case dur < time.Minute:
+		return d.phrase("duration-seconds", int(dur/time.Second))
+	case dur < time.Hour:
+		return d.phrase("duration-minutes", int(dur/time.Minute))
+	case dur < 24*time.Hour:
+		hours, minutes := int(dur/time.Hour), int(dur%time.Hour/time.Minute)
+		if minutes == 0 {
+			return d.phrase("duration-hours", hours)
+		}
+		return d.phrase("duration-hours-mins", hours, Description{textPart(strconv.Itoa(hours))}, Description{textPart(strconv.Itoa(minutes))})
+	}
+	return d.phrase("duration-days", int(dur/(24*time.Hour)))
+}
+
+// runTime formats the time of a run in the target location, with the day of
+// the week for runs within a week of now and the date otherwise.
+func (d *describer) runTime(t, now time.Time) Description {
+	t, now = t.In(d.target), now.In(d.target)
+	text := func(s string) Description {
+		return Description{textPart(s)}
+	}
+	var clock string
+	if d.layout != "" {
+		clock = t.Format(d.layout)
+	} else {
+		style := d.style
+		style.Seconds = style.Seconds || t.Second() != 0
+		clock = d.t.Time(t.Hour(), t.Minute(), t.Second(), style)
+	}
+	zone, _ := t.Zone()
+	day := text(d.t.DayName(t.Weekday(), true))
+
+	days := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Sub(
+		time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) / (24 * time.Hour)
+	if days > -7 && days < 7 {
+		return d.phrase("run-weekday", 0, day, text(clock), text(zone))
+	}
+	return d.phrase("run-date", 0, day, text(d.t.MonthName(t.Month(), true)), text(strconv.Itoa(t.Day())),
+		text(strconv.Itoa(t.Year())), text(clock), text(zone))
+}
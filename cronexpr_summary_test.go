@@ -0,0 +1,33 @@
+package cronexpr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestSummary(t *testing.T) {
+	cest := time.FixedZone("CEST", 2*60*60)
+	now := time.Date(2026, 10, 20, 5, 48, 0, 0, cest)
+	opts := &cronexpr.DescribeOptions{SourceLocation: cest, TargetLocation: cest}
+	tests := []struct {
+		expr string
+		opts *cronexpr.DescribeOptions
+		want string
+	}{
+		{"0 9 * * 1-5", opts, "At 9:00 AM, on weekdays — next run in 3h 12m (Tue 9:00 AM CEST), last run 20h 48m ago (Mon 9:00 AM CEST)"},
+		{"0 9 * * 1-5", &cronexpr.DescribeOptions{SourceLocation: cest, TargetLocation: cest, Locale: "de"},
+			"Um 9:00 Uhr, wochentags — nächste Ausführung in 3 h 12 min (Di 9:00 Uhr CEST), letzte Ausführung vor 20 h 48 min (Mo 9:00 Uhr CEST)"},
+		{"0 6 * * *", opts, "At 6:00 AM — next run in 12m (Tue 6:00 AM CEST), last run 23h 48m ago (Mon 6:00 AM CEST)"},
+		{"0 9 * * 5", opts, "At 9:00 AM, Friday only — next run in 3 days (Fri 9:00 AM CEST), last run 3 days ago (Fri 9:00 AM CEST)"},
+		{"*/10 * * * * * *", nil, "Every 10 seconds — next run in 10s (Tue 3:48:10 AM UTC), last run 10s ago (Tue 3:47:50 AM UTC)"},
+		{"0 0 0 1 1 * 2020", nil, "At 12:00 AM, on the 1st of the month only in January, only in 2020 — no further runs, last run 2484 days ago (Wed, Jan 1, 2020, 12:00 AM UTC)"},
+		{"0 0 0 1 1 * 2030", nil, "At 12:00 AM, on the 1st of the month only in January, only in 2030 — next run in 1168 days (Tue, Jan 1, 2030, 12:00 AM UTC)"},
+	}
+	for _, tt := range tests {
+		if got := cronexpr.MustParse(tt.expr).Summary(now, tt.opts); got != tt.want {
+			t.Errorf("Summary(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
@@ -299,6 +299,141 @@ func TestExpressions(t *testing.T) {
 	}
 }
 
+// TestPrevInvertsNext checks that Prev undoes Next for every crontest case:
+// nothing matches strictly between from and Next(from), so stepping back from
+// Next(from) must land at or before from.
+func TestPrevInvertsNext(t *testing.T) {
+	for _, test := range crontests {
+		t.Run(test.name, func(t *testing.T) {
+			expr := MustParse(test.expr)
+			for _, times := range test.times {
+				from, _ := time.Parse("2006-01-02 15:04:05", times.from)
+				next := expr.Next(from)
+				prev := expr.Prev(next)
+				if prev.After(from) {
+					t.Errorf(`("%s").Prev("%v") = "%v", after from "%v"`, test.expr, next, prev, from)
+				}
+				if !prev.IsZero() && !expr.Next(prev).Equal(next) {
+					t.Errorf(`("%s").Next(Prev("%v")) = "%v"`, test.expr, next, expr.Next(prev))
+				}
+			}
+		})
+	}
+}
+
+func TestPrev(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		prev string
+	}{
+		{"SameMinute", "*/5 * * * *", time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC), "2026-01-01 00:00:00 UTC"},
+		{"SubSecond", "*/5 * * * *", time.Date(2026, 1, 1, 0, 5, 0, 1, time.UTC), "2026-01-01 00:05:00 UTC"},
+		{"YearBoundary", "0 0 1 1 *", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "2025-01-01 00:00:00 UTC"},
+		{"LastDayOfMonth", "0 0 L * *", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), "2024-02-29 00:00:00 UTC"},
+		{"LastWorkday", "0 0 LW * *", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), "2026-02-27 00:00:00 UTC"},
+		{"FifthSaturday", "0 0 * * 6#5", time.Date(2014, 3, 1, 0, 0, 0, 0, time.UTC), "2013-11-30 00:00:00 UTC"},
+		{"BeforeFirstYear", "0 0 1 1 * 2026", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "0001-01-01 00:00:00 UTC"},
+		// 02:30 on 2026-03-08 runs at 03:30 EDT, which is not before 03:10.
+		{"SpringForwardGap", "30 2 * * *", time.Date(2026, 3, 8, 3, 10, 0, 0, ny), "2026-03-07 02:30:00 EST"},
+		{"SpringForwardAfter", "30 2 * * *", time.Date(2026, 3, 8, 4, 0, 0, 0, ny), "2026-03-08 03:30:00 EDT"},
+		// 01:00–01:59 repeats on 2026-11-01; runs match the EDT copy only.
+		{"FallBackSecondCopyBefore", "20 1 * * *", time.Date(2026, 11, 1, 6, 10, 0, 0, time.UTC).In(ny), "2026-11-01 01:20:00 EDT"},
+		{"FallBackSecondCopyAfter", "20 1 * * *", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC).In(ny), "2026-11-01 01:20:00 EDT"},
+		{"FallBackFirstCopyBefore", "20 1 * * *", time.Date(2026, 11, 1, 5, 10, 0, 0, time.UTC).In(ny), "2026-10-31 01:20:00 EDT"},
+		{"FallBackFirstCopyAfter", "20 1 * * *", time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC).In(ny), "2026-11-01 01:20:00 EDT"},
+		{"FallBackAfterRepeat", "20 1,2 * * *", time.Date(2026, 11, 1, 7, 10, 0, 0, time.UTC).In(ny), "2026-11-01 01:20:00 EDT"},
+		{"FallBackEveryMinute", "* * * * *", time.Date(2026, 11, 1, 6, 10, 0, 0, time.UTC).In(ny), "2026-11-01 01:09:00 EST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := MustParse(tt.expr).Prev(tt.from)
+			if got := prev.Format("2006-01-02 15:04:05 MST"); got != tt.prev {
+				t.Errorf(`("%s").Prev(%v) = %q, want %q`, tt.expr, tt.from, got, tt.prev)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name string
+		expr string
+		at   time.Time
+		want bool
+	}{
+		{"Match", "0 9 * * MON-FRI", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), true},
+		{"FractionalSecond", "0 9 * * MON-FRI", time.Date(2026, 10, 19, 9, 0, 0, 500, time.UTC), true},
+		{"WrongDay", "0 9 * * MON-FRI", time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), false},
+		{"WrongSecond", "0 9 * * MON-FRI", time.Date(2026, 10, 19, 9, 0, 1, 0, time.UTC), false},
+		{"SpringForwardShifted", "30 2 * * *", time.Date(2026, 3, 8, 3, 30, 0, 0, ny), true},
+		{"FallBackFirst", "30 1 * * *", time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC).In(ny), true},
+		{"FallBackSecond", "30 1 * * *", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC).In(ny), false},
+		{"Zero", "* * * * *", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustParse(tt.expr).Matches(tt.at); got != tt.want {
+				t.Errorf(`("%s").Matches(%v) = %v, want %v`, tt.expr, tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextDaylightSaving(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		next string
+	}{
+		// 02:30 is skipped on 2026-03-08; the run happens once clocks jump.
+		{"SpringForwardGap", "30 2 * * *", time.Date(2026, 3, 7, 2, 30, 0, 0, ny), "2026-03-08 03:30:00 EDT"},
+		{"SpringForwardAfterGap", "30 2 * * *", time.Date(2026, 3, 8, 3, 30, 0, 0, ny), "2026-03-09 02:30:00 EDT"},
+		// 01:30 occurs twice on 2026-11-01; the second occurrence is skipped.
+		{"FallBackFirst", "30 1 * * *", time.Date(2026, 11, 1, 0, 0, 0, 0, ny), "2026-11-01 01:30:00 EDT"},
+		{"FallBackSecond", "30 1 * * *", time.Date(2026, 11, 1, 6, 10, 0, 0, time.UTC).In(ny), "2026-11-02 01:30:00 EST"},
+		// Step schedules follow the clock: they skip the gap and run in
+		// both copies of the repeated hour.
+		{"StepSpringForward", "*/15 * * * *", time.Date(2026, 3, 8, 1, 50, 0, 0, ny), "2026-03-08 03:00:00 EDT"},
+		{"StepFallBackFirst", "*/15 * * * *", time.Date(2026, 11, 1, 1, 50, 0, 0, ny), "2026-11-01 01:00:00 EST"},
+		{"StepFallBackSecond", "*/15 * * * *", time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC).In(ny), "2026-11-01 01:15:00 EST"},
+		{"HourStepFallBack", "30 */1 * * *", time.Date(2026, 11, 1, 5, 40, 0, 0, time.UTC).In(ny), "2026-11-01 01:30:00 EST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := MustParse(tt.expr).Next(tt.from)
+			if got := next.Format("2006-01-02 15:04:05 MST"); got != tt.next {
+				t.Errorf(`("%s").Next(%v) = %q, want %q`, tt.expr, tt.from, got, tt.next)
+			}
+			if !next.After(tt.from) {
+				t.Errorf(`("%s").Next(%v) = %v, not after from`, tt.expr, tt.from, next)
+			}
+		})
+	}
+
+	// Across the New York fall-back, */15 keeps firing every 15 minutes.
+	times := MustParse("*/15 * * * *").NextN(time.Date(2026, 11, 1, 0, 0, 0, 0, ny), 12)
+	for i := 1; i < len(times); i++ {
+		if d := times[i].Sub(times[i-1]); d != 15*time.Minute {
+			t.Errorf("*/15 runs %v after %v, want 15m0s", d, times[i-1])
+		}
+	}
+}
+
 func TestZero(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -309,6 +444,8 @@ func TestZero(t *testing.T) {
 		{"PastYear", "* * * * * 1980", "2013-08-31", true},
 		{"FutureYear", "* * * * * 2050", "2013-08-31", false},
 		{"ZeroTime", "* * * * * 2099", "0001-01-01", true},
+		{"DayZero", "0 0 0 * *", "2013-08-31", true},
+		{"DayThirtyTwo", "0 0 32W * *", "2013-08-31", true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -397,6 +534,35 @@ func TestInterval_Interval60Issue(t *testing.T) {
 	}
 }
 
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		offset int
+		length int
+		msg    string
+	}{
+		{"MissingFields", "0 9 * *", 7, 0, "missing field(s)"},
+		{"BadEntry", "0 9 1,1-5x * *", 6, 4, "syntax error in day-of-month field: '1-5x'"},
+		{"BadInterval", "*/60 * * * *", 0, 4, "invalid interval */60"},
+		{"BadDayOfWeek", "0  9 * * MON#9", 9, 5, "syntax error in day-of-week field: 'MON#9'"},
+		{"BadYear", "0 0 9 * * * 1900", 12, 4, "syntax error in year field: '1900'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			pe, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("Parse(%q) error = %#v, want *ParseError", tt.expr, err)
+			}
+			if pe.Offset != tt.offset || pe.Length != tt.length || pe.Msg != tt.msg {
+				t.Errorf("Parse(%q) = {Offset: %d, Length: %d, Msg: %q}, want {%d, %d, %q}",
+					tt.expr, pe.Offset, pe.Length, pe.Msg, tt.offset, tt.length, tt.msg)
+			}
+		})
+	}
+}
+
 var benchmarkExpressions = []string{
 	"* * * * *",
 	"@hourly",
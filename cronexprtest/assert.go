@@ -0,0 +1,51 @@
+package cronexprtest
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+// Fires runs expr on a FakeClock starting at from and returns every instant
+// at which it fires, up to and including to. Each wait is a timer on the fake
+// clock, so the window is simulated without sleeping.
+func Fires(expr *cronexpr.Expression, from, to time.Time) []time.Time {
+	clock := NewFakeClock(from)
+	var fired []time.Time
+	for {
+		now := clock.Now()
+		next := expr.Next(now)
+		if next.IsZero() || next.After(to) {
+			return fired
+		}
+		timer := clock.NewTimer(next.Sub(now))
+		clock.Set(next)
+		fired = append(fired, <-timer.C())
+	}
+}
+
+// AssertFires reports an error on t unless expr fires exactly at want between
+// from and to inclusive. Instants are compared with time.Time.Equal.
+func AssertFires(t testing.TB, expr *cronexpr.Expression, from, to time.Time, want ...time.Time) {
+	t.Helper()
+	got := Fires(expr, from, to)
+	if slices.EqualFunc(got, want, time.Time.Equal) {
+		return
+	}
+	t.Errorf("fires between %s and %s:\n got: %s\nwant: %s",
+		from.Format(time.RFC3339), to.Format(time.RFC3339), formatTimes(got), formatTimes(want))
+}
+
+func formatTimes(times []time.Time) string {
+	if len(times) == 0 {
+		return "(none)"
+	}
+	s := make([]string, len(times))
+	for i, t := range times {
+		s[i] = t.Format(time.RFC3339)
+	}
+	return strings.Join(s, ", ")
+}
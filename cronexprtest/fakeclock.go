@@ -0,0 +1,159 @@
+// Package cronexprtest provides a manually advanced clock and assertion
+// helpers for testing code that waits on cron schedules.
+package cronexprtest
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+// FakeClock is a cronexpr.Clock whose time only moves when Advance or Set is
+// called. Timers fire in deadline order as time passes them. It is safe for
+// concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	seq    uint64
+	timers []*fakeTimer
+}
+
+var _ cronexpr.Clock = (*FakeClock)(nil)
+
+// NewFakeClock returns a FakeClock set to now. Times it reports are in the
+// location of now.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a Timer that fires once the clock has advanced by d. A
+// timer with d <= 0 fires immediately.
+func (c *FakeClock) NewTimer(d time.Duration) cronexpr.Timer {
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	c.mu.Lock()
+	c.schedule(t, d)
+	c.mu.Unlock()
+	return t
+}
+
+// After is shorthand for NewTimer(d).C().
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline is
+// reached along the way in chronological order. While a timer fires, Now
+// reports its deadline.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+	c.Set(target)
+}
+
+// Set moves the clock to t, firing due timers as Advance does. Moving the
+// clock backwards fires nothing.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) > 0 && !c.timers[0].deadline.After(t) {
+		ft := c.timers[0]
+		c.timers = c.timers[1:]
+		if ft.deadline.After(c.now) {
+			c.now = ft.deadline.In(c.now.Location())
+		}
+		ft.fire(c.now)
+	}
+	c.now = t.In(c.now.Location())
+	c.cond.Broadcast()
+}
+
+// Pending returns the number of timers waiting to fire.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers are waiting to fire. Use it to
+// wait for a goroutine to arm its next timer before calling Advance.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// schedule arms t to fire d after the current time. The caller holds c.mu.
+func (c *FakeClock) schedule(t *fakeTimer, d time.Duration) {
+	if d <= 0 {
+		t.fire(c.now)
+		return
+	}
+	c.seq++
+	t.seq = c.seq
+	t.deadline = c.now.Add(d)
+	i, _ := slices.BinarySearchFunc(c.timers, t, func(a, b *fakeTimer) int {
+		return cmp.Or(a.deadline.Compare(b.deadline), cmp.Compare(a.seq, b.seq))
+	})
+	c.timers = slices.Insert(c.timers, i, t)
+	c.cond.Broadcast()
+}
+
+// unschedule removes t from the pending timers, reporting whether it was
+// pending. The caller holds c.mu.
+func (c *FakeClock) unschedule(t *fakeTimer) bool {
+	i := slices.Index(c.timers, t)
+	if i < 0 {
+		return false
+	}
+	c.timers = slices.Delete(c.timers, i, i+1)
+	c.cond.Broadcast()
+	return true
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	c        chan time.Time
+	deadline time.Time
+	seq      uint64
+}
+
+// fire delivers now without blocking, dropping the value if the previous one
+// has not been received, as time.Timer does.
+func (t *fakeTimer) fire(now time.Time) {
+	select {
+	case t.c <- now:
+	default:
+	}
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.unschedule(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	active := t.clock.unschedule(t)
+	t.clock.schedule(t, d)
+	return active
+}
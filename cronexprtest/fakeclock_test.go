@@ -0,0 +1,133 @@
+package cronexprtest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/cronexprtest"
+)
+
+func TestFakeClock_FiresInOrder(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := cronexprtest.NewFakeClock(start)
+
+	late := clock.NewTimer(3 * time.Second)
+	early := clock.NewTimer(1 * time.Second)
+	stopped := clock.NewTimer(2 * time.Second)
+	if !stopped.Stop() {
+		t.Fatal("Stop() on pending timer = false, want true")
+	}
+	if clock.Pending() != 2 {
+		t.Fatalf("Pending() = %d, want 2", clock.Pending())
+	}
+
+	clock.Advance(2 * time.Second)
+	select {
+	case got := <-early.C():
+		if want := start.Add(time.Second); !got.Equal(want) {
+			t.Errorf("early fired at %v, want %v", got, want)
+		}
+	default:
+		t.Fatal("early timer did not fire")
+	}
+	select {
+	case <-late.C():
+		t.Fatal("late timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	if got := <-late.C(); !got.Equal(start.Add(3 * time.Second)) {
+		t.Errorf("late fired at %v, want %v", got, start.Add(3*time.Second))
+	}
+	if got := clock.Now(); !got.Equal(start.Add(3 * time.Second)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(3*time.Second))
+	}
+}
+
+func TestFakeClock_ResetAndBlockUntil(t *testing.T) {
+	clock := cronexprtest.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	timer := clock.NewTimer(time.Minute)
+	if !timer.Reset(time.Hour) {
+		t.Error("Reset() on pending timer = false, want true")
+	}
+	clock.Advance(time.Minute)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired at its original deadline after Reset")
+	default:
+	}
+
+	done := make(chan time.Time)
+	go func() { done <- <-clock.After(time.Second) }()
+	clock.BlockUntil(2)
+	clock.Advance(time.Second)
+	if got, want := <-done, clock.Now(); !got.Equal(want) {
+		t.Errorf("After fired at %v, want %v", got, want)
+	}
+}
+
+func TestAssertFires(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name     string
+		expr     string
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name: "YearBoundary",
+			expr: "0 0 1 1 *",
+			from: time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC),
+			to:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "LeapDay",
+			expr: "0 12 29 2 *",
+			from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2033, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC),
+				time.Date(2032, 2, 29, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// 02:30 does not exist on 2026-03-08 in New York; the run moves
+			// to 03:30 EDT, one hour after 01:30 EST.
+			name: "SpringForward",
+			expr: "30 2 * * *",
+			from: time.Date(2026, 3, 7, 0, 0, 0, 0, ny),
+			to:   time.Date(2026, 3, 9, 23, 0, 0, 0, ny),
+			want: []time.Time{
+				time.Date(2026, 3, 7, 2, 30, 0, 0, ny),
+				time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC),
+				time.Date(2026, 3, 9, 2, 30, 0, 0, ny),
+			},
+		},
+		{
+			// 01:30 occurs twice on 2026-11-01 in New York; it fires once.
+			name: "FallBack",
+			expr: "30 1 * * *",
+			from: time.Date(2026, 10, 31, 0, 0, 0, 0, ny),
+			to:   time.Date(2026, 11, 2, 23, 0, 0, 0, ny),
+			want: []time.Time{
+				time.Date(2026, 10, 31, 1, 30, 0, 0, ny),
+				time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC),
+				time.Date(2026, 11, 2, 1, 30, 0, 0, ny),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronexprtest.AssertFires(t, cronexpr.MustParse(tt.expr), tt.from, tt.to, tt.want...)
+		})
+	}
+}
@@ -0,0 +1,339 @@
+// Package crontab parses whole crontab files: user crontabs, whose lines are
+// "schedule command", and system crontabs such as /etc/crontab and the files
+// in /etc/cron.d, whose lines are "schedule user command".
+package crontab
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+// Kind selects the line layout of a crontab.
+type Kind int
+
+const (
+	// User crontabs, as edited with crontab -e, have no user column.
+	User Kind = iota
+	// System crontabs have a user column between schedule and command.
+	System
+)
+
+// scheduleFields is the number of fields in a crontab schedule. The 6- and
+// 7-field forms accepted by cronexpr.Parse are not used in crontabs, where
+// the sixth column is the user or the command.
+const scheduleFields = 5
+
+// aliases maps the @-schedules understood by cron to their canonical
+// spelling. @reboot has no expression.
+var aliases = map[string]string{
+	"@yearly":   "@yearly",
+	"@annually": "@yearly",
+	"@monthly":  "@monthly",
+	"@weekly":   "@weekly",
+	"@daily":    "@daily",
+	"@midnight": "@daily",
+	"@hourly":   "@hourly",
+}
+
+// expansions gives the five schedule fields each canonical alias stands for.
+var expansions = map[string]string{
+	"@yearly":  "0 0 1 1 *",
+	"@monthly": "0 0 1 * *",
+	"@weekly":  "0 0 * * 0",
+	"@daily":   "0 0 * * *",
+	"@hourly":  "0 * * * *",
+}
+
+// File is a parsed crontab.
+type File struct {
+	// Entries are the job lines in file order.
+	Entries []*Entry
+	// Env holds the environment assignments in file order.
+	Env []EnvVar
+	// Diagnostics report the lines that could not be parsed. Those lines
+	// produce no Entry.
+	Diagnostics []*Diagnostic
+}
+
+// EnvVar is an environment assignment such as MAILTO=ops@example.com.
+type EnvVar struct {
+	Line  int
+	Name  string
+	Value string
+}
+
+// Field is a whitespace-separated column of a crontab line.
+type Field struct {
+	Text string
+	// Column is the 1-based byte column of Text in its line.
+	Column int
+}
+
+// Entry is a job line of a crontab.
+type Entry struct {
+	// Line is the 1-based number of the line the entry starts on.
+	Line int
+	// Schedule holds the schedule fields: five for a cron expression, or a
+	// single @-alias.
+	Schedule []Field
+	// Expr is the parsed schedule. It is nil for @reboot.
+	Expr *cronexpr.Expression
+	// Reboot reports an @reboot entry, which runs once at cron startup.
+	Reboot bool
+	// User is the account the command runs as; empty in user crontabs.
+	User Field
+	// Command is the command text up to the first unescaped %, with \%
+	// unescaped.
+	Command string
+	// Stdin is the text after the first unescaped %, with the remaining
+	// unescaped % characters turned into newlines. cron feeds it to the
+	// command on standard input.
+	Stdin string
+	// HasStdin reports whether the command contained an unescaped %, so an
+	// empty Stdin can be told apart from none.
+	HasStdin bool
+	// Env is the environment in effect for the entry: every assignment made
+	// before it in the file.
+	Env map[string]string
+	// Location is the time zone set by the CRON_TZ assignment in effect for
+	// the entry, or nil.
+	Location *time.Location
+}
+
+// ScheduleText returns the schedule fields joined by single spaces.
+func (e *Entry) ScheduleText() string {
+	texts := make([]string, len(e.Schedule))
+	for i, f := range e.Schedule {
+		texts[i] = f.Text
+	}
+	return strings.Join(texts, " ")
+}
+
+// Diagnostic reports a problem at a position in a crontab.
+type Diagnostic struct {
+	// Line and Column locate the problem, both 1-based.
+	Line, Column int
+	// Err describes the problem. Schedule errors wrap the *cronexpr.ParseError
+	// returned by cronexpr.Parse.
+	Err error
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", d.Line, d.Column, d.Err)
+}
+
+func (d *Diagnostic) Unwrap() error { return d.Err }
+
+// Parse reads a crontab of the given kind from r. Lines that cannot be parsed
+// are reported in File.Diagnostics; the returned error is only for failures
+// to read r.
+//
+// Comments and blank lines are skipped. A line ending in a backslash
+// continues on the next line; positions on continued lines are reported
+// relative to the joined line.
+func Parse(r io.Reader, kind Kind) (*File, error) {
+	p := parser{kind: kind, file: &File{}, env: make(map[string]string)}
+	if err := scanLines(r, p.parseLine); err != nil {
+		return nil, err
+	}
+	return p.file, nil
+}
+
+// scanLines calls fn with each logical line of r and the 1-based number of
+// the line it starts on, joining lines that end in a backslash.
+func scanLines(r io.Reader, fn func(lineNo int, line string)) error {
+	sc := bufio.NewScanner(r)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		start := lineNo
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		for strings.HasSuffix(line, `\`) && sc.Scan() {
+			lineNo++
+			line = line[:len(line)-1] + strings.TrimSuffix(sc.Text(), "\r")
+		}
+		fn(start, line)
+	}
+	return sc.Err()
+}
+
+// parser holds the state carried from line to line.
+type parser struct {
+	kind Kind
+	file *File
+	env  map[string]string
+	loc  *time.Location
+}
+
+func (p *parser) parseLine(lineNo int, line string) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return
+	}
+	if name, value, ok := parseAssignment(trimmed); ok {
+		p.assign(lineNo, line, name, value)
+		return
+	}
+
+	fields := splitFields(line)
+	entry := &Entry{Line: lineNo, Env: p.snapshotEnv(), Location: p.loc}
+	rest := 0 // index into fields of the first field after the schedule
+	if strings.HasPrefix(fields[0].Text, "@") {
+		alias := strings.ToLower(fields[0].Text)
+		entry.Schedule = fields[:1]
+		rest = 1
+		if alias == "@reboot" {
+			entry.Reboot = true
+		} else if canonical, ok := aliases[alias]; ok {
+			entry.Expr = cronexpr.MustParse(expansions[canonical])
+		} else {
+			p.report(lineNo, fields[0].Column, fmt.Errorf("unknown schedule alias %q", fields[0].Text))
+			return
+		}
+	} else {
+		if len(fields) < scheduleFields {
+			p.report(lineNo, len(line)+1, errors.New("missing field(s)"))
+			return
+		}
+		entry.Schedule = fields[:scheduleFields]
+		rest = scheduleFields
+		expr, err := cronexpr.Parse(entry.ScheduleText())
+		if err != nil {
+			p.report(lineNo, scheduleColumn(entry.Schedule, err), err)
+			return
+		}
+		entry.Expr = expr
+	}
+
+	if p.kind == System {
+		if rest >= len(fields) {
+			p.report(lineNo, len(line)+1, errors.New("missing user"))
+			return
+		}
+		entry.User = fields[rest]
+		rest++
+	}
+	if rest >= len(fields) {
+		p.report(lineNo, len(line)+1, errors.New("missing command"))
+		return
+	}
+	command := strings.TrimRightFunc(line[fields[rest].Column-1:], isSpace)
+	entry.Command, entry.Stdin, entry.HasStdin = splitPercent(command)
+	p.file.Entries = append(p.file.Entries, entry)
+}
+
+// assign records an environment assignment, loading the time zone named by
+// CRON_TZ.
+func (p *parser) assign(lineNo int, line, name, value string) {
+	if name == "CRON_TZ" {
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			p.report(lineNo, strings.Index(line, "=")+2, fmt.Errorf("CRON_TZ: %w", err))
+			return
+		}
+		p.loc = loc
+	}
+	p.env[name] = value
+	p.file.Env = append(p.file.Env, EnvVar{Line: lineNo, Name: name, Value: value})
+}
+
+func (p *parser) report(lineNo, column int, err error) {
+	p.file.Diagnostics = append(p.file.Diagnostics, &Diagnostic{Line: lineNo, Column: column, Err: err})
+}
+
+func (p *parser) snapshotEnv() map[string]string {
+	env := make(map[string]string, len(p.env))
+	for k, v := range p.env {
+		env[k] = v
+	}
+	return env
+}
+
+// parseAssignment recognizes NAME=value lines the way cron does: the text
+// before the first = must be a single word. The value may be quoted.
+func parseAssignment(line string) (name, value string, ok bool) {
+	name, value, ok = strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsFunc(name, isSpace) {
+		return "", "", false
+	}
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return name, value, true
+}
+
+// scheduleColumn maps the position of a cronexpr.ParseError in the joined
+// schedule text back to a column of the line.
+func scheduleColumn(schedule []Field, err error) int {
+	var pe *cronexpr.ParseError
+	if !errors.As(err, &pe) {
+		return schedule[0].Column
+	}
+	offset := pe.Offset
+	for _, f := range schedule {
+		if offset <= len(f.Text) {
+			return f.Column + offset
+		}
+		offset -= len(f.Text) + 1
+	}
+	last := schedule[len(schedule)-1]
+	return last.Column + len(last.Text)
+}
+
+// splitPercent applies cron's % rules to a command: the first unescaped %
+// ends the command and starts its standard input, in which each further
+// unescaped % is a newline. \% stands for a literal %.
+func splitPercent(s string) (command, stdin string, hasStdin bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == '%':
+			b.WriteByte('%')
+			i++
+		case s[i] == '%' && !hasStdin:
+			command = b.String()
+			b.Reset()
+			hasStdin = true
+		case s[i] == '%':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	if hasStdin {
+		return command, b.String(), true
+	}
+	return b.String(), "", false
+}
+
+// splitFields splits line on whitespace, recording the column of each field.
+func splitFields(line string) []Field {
+	var fields []Field
+	start := -1
+	for i := 0; i <= len(line); i++ {
+		if i == len(line) || isSpace(rune(line[i])) {
+			if start >= 0 {
+				fields = append(fields, Field{Text: line[start:i], Column: start + 1})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return fields
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
@@ -0,0 +1,156 @@
+package crontab_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/crontab"
+)
+
+func TestParse_User(t *testing.T) {
+	const src = `# m h dom mon dow command
+SHELL=/bin/bash
+MAILTO="ops@example.com"
+
+*/15 9-17 * * 1-5  /usr/local/bin/poll --verbose
+@reboot /usr/local/bin/start
+@midnight backup.sh
+0 6 * * * mail -s report ops%Hello,%%Regards\%
+30 2 * * * /bin/long \
+  --flag
+CRON_TZ=America/New_York
+0 9 * * * greet
+`
+	f, err := crontab.Parse(strings.NewReader(src), crontab.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Diagnostics) != 0 {
+		t.Fatalf("Diagnostics = %v", f.Diagnostics)
+	}
+	if len(f.Env) != 3 || f.Env[1].Name != "MAILTO" || f.Env[1].Value != "ops@example.com" {
+		t.Errorf("Env = %+v", f.Env)
+	}
+	if len(f.Entries) != 6 {
+		t.Fatalf("got %d entries, want 6", len(f.Entries))
+	}
+
+	poll := f.Entries[0]
+	if poll.Line != 5 || poll.ScheduleText() != "*/15 9-17 * * 1-5" || poll.Command != "/usr/local/bin/poll --verbose" {
+		t.Errorf("entry 0 = line %d, %q, %q", poll.Line, poll.ScheduleText(), poll.Command)
+	}
+	if poll.Env["SHELL"] != "/bin/bash" || poll.Location != nil {
+		t.Errorf("entry 0 env = %v, location %v", poll.Env, poll.Location)
+	}
+	if poll.Schedule[4].Column != 15 {
+		t.Errorf("day-of-week column = %d, want 15", poll.Schedule[4].Column)
+	}
+
+	if reboot := f.Entries[1]; !reboot.Reboot || reboot.Expr != nil {
+		t.Errorf("@reboot entry: Reboot = %v, Expr = %v", reboot.Reboot, reboot.Expr)
+	}
+
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	if got, want := f.Entries[2].Expr.Next(from), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("@midnight Next = %v, want %v", got, want)
+	}
+
+	mail := f.Entries[3]
+	if mail.Command != "mail -s report ops" || !mail.HasStdin || mail.Stdin != "Hello,\n\nRegards%" {
+		t.Errorf("entry 3 command %q, stdin %q", mail.Command, mail.Stdin)
+	}
+
+	if long := f.Entries[4]; long.Line != 9 || long.Command != "/bin/long   --flag" {
+		t.Errorf("continued entry = line %d, %q", long.Line, long.Command)
+	}
+
+	greet := f.Entries[5]
+	if greet.Location == nil || greet.Location.String() != "America/New_York" {
+		t.Errorf("CRON_TZ location = %v", greet.Location)
+	}
+	if _, ok := f.Entries[4].Env["CRON_TZ"]; ok {
+		t.Error("CRON_TZ leaked into an earlier entry")
+	}
+}
+
+func TestParse_CRLF(t *testing.T) {
+	const src = "SHELL=/bin/sh\r\n30 2 * * * /bin/long \\\r\n  --flag\r\n0 9 * * * greet\r\n"
+	f, err := crontab.Parse(strings.NewReader(src), crontab.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Diagnostics) != 0 {
+		t.Fatalf("Diagnostics = %v", f.Diagnostics)
+	}
+	if len(f.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(f.Entries))
+	}
+	if f.Env[0].Value != "/bin/sh" {
+		t.Errorf("SHELL = %q", f.Env[0].Value)
+	}
+	if long := f.Entries[0]; long.Line != 2 || long.Command != "/bin/long   --flag" {
+		t.Errorf("continued entry = line %d, %q", long.Line, long.Command)
+	}
+	if greet := f.Entries[1]; greet.Line != 4 || greet.Command != "greet" {
+		t.Errorf("entry 1 = line %d, %q", greet.Line, greet.Command)
+	}
+}
+
+func TestParse_System(t *testing.T) {
+	const src = "17 * * * * root cd / && run-parts --report /etc/cron.hourly\n" +
+		"@weekly nobody /bin/true\n" +
+		"0 0 * * * root\n"
+	f, err := crontab.Parse(strings.NewReader(src), crontab.System)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(f.Entries))
+	}
+	if e := f.Entries[0]; e.User.Text != "root" || e.User.Column != 12 || e.Command != "cd / && run-parts --report /etc/cron.hourly" {
+		t.Errorf("entry 0 user %+v, command %q", e.User, e.Command)
+	}
+	if e := f.Entries[1]; e.User.Text != "nobody" || e.Command != "/bin/true" {
+		t.Errorf("entry 1 user %+v, command %q", e.User, e.Command)
+	}
+	if len(f.Diagnostics) != 1 || f.Diagnostics[0].Line != 3 {
+		t.Fatalf("Diagnostics = %v", f.Diagnostics)
+	}
+}
+
+func TestParse_Diagnostics(t *testing.T) {
+	tests := []struct {
+		line       string
+		wantColumn int
+		wantParse  bool
+	}{
+		{"0 9 * *", 8, false},
+		{"0  9 1,1-5x * * cmd", 8, true},
+		{"0 9 * * MON#9 cmd", 9, true},
+		{"@fortnightly cmd", 1, false},
+		{"0 9 * * *", 10, false},
+		{"CRON_TZ=Nowhere/Special", 9, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			f, err := crontab.Parse(strings.NewReader("\n"+tt.line+"\n"), crontab.User)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(f.Entries) != 0 || len(f.Diagnostics) != 1 {
+				t.Fatalf("entries %d, diagnostics %v", len(f.Entries), f.Diagnostics)
+			}
+			d := f.Diagnostics[0]
+			if d.Line != 2 || d.Column != tt.wantColumn {
+				t.Errorf("position = %d:%d, want 2:%d (%v)", d.Line, d.Column, tt.wantColumn, d)
+			}
+			var pe *cronexpr.ParseError
+			if errors.As(d, &pe) != tt.wantParse {
+				t.Errorf("errors.As(*cronexpr.ParseError) = %v, want %v", !tt.wantParse, tt.wantParse)
+			}
+		})
+	}
+}
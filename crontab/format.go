@@ -0,0 +1,139 @@
+package crontab
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+)
+
+// Format rewrites the crontab src in canonical form:
+//
+//   - schedule fields are spelled canonically: names in upper case, ? as *,
+//     steps of 1 on * and ranges dropped and 7 for Sunday written as 0
+//   - alias synonyms are replaced, @annually by @yearly and @midnight by
+//     @daily
+//   - the columns of each run of consecutive entries are aligned
+//
+// Comments, environment assignments and lines that do not parse are kept as
+// they are, without trailing whitespace. Continued lines are joined.
+func Format(src []byte, kind Kind) ([]byte, error) {
+	f, err := Parse(bytes.NewReader(src), kind)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[int]*Entry, len(f.Entries))
+	for _, e := range f.Entries {
+		entries[e.Line] = e
+	}
+
+	var out bytes.Buffer
+	var block []formattedEntry
+	err = scanLines(bytes.NewReader(src), func(lineNo int, line string) {
+		if e, ok := entries[lineNo]; ok {
+			block = append(block, formatEntry(e, line, kind))
+			return
+		}
+		writeBlock(&out, block)
+		block = block[:0]
+		out.WriteString(strings.TrimRightFunc(line, unicode.IsSpace))
+		out.WriteByte('\n')
+	})
+	if err != nil {
+		return nil, err
+	}
+	writeBlock(&out, block)
+	return out.Bytes(), nil
+}
+
+// formattedEntry holds the canonical columns of an entry before alignment.
+type formattedEntry struct {
+	schedule []string // five fields, or a single alias
+	user     string
+	command  string
+}
+
+func formatEntry(e *Entry, line string, kind Kind) formattedEntry {
+	fe := formattedEntry{user: e.User.Text}
+	if len(e.Schedule) == 1 {
+		alias := strings.ToLower(e.Schedule[0].Text)
+		if canonical, ok := aliases[alias]; ok {
+			alias = canonical
+		}
+		fe.schedule = []string{alias}
+	} else {
+		for i, f := range e.Schedule {
+			fe.schedule = append(fe.schedule, canonicalField(i, f.Text))
+		}
+	}
+	// The command is kept verbatim, including its % escapes.
+	rest := len(e.Schedule)
+	if kind == System {
+		rest++
+	}
+	fields := splitFields(line)
+	fe.command = strings.TrimRightFunc(line[fields[rest].Column-1:], unicode.IsSpace)
+	return fe
+}
+
+// writeBlock writes a run of consecutive entries with aligned columns.
+func writeBlock(out *bytes.Buffer, block []formattedEntry) {
+	var widths [scheduleFields]int
+	for _, fe := range block {
+		if len(fe.schedule) == scheduleFields {
+			for i, field := range fe.schedule {
+				widths[i] = max(widths[i], len(field))
+			}
+		}
+	}
+	schedules := make([]string, len(block))
+	scheduleWidth, userWidth := 0, 0
+	for i, fe := range block {
+		if len(fe.schedule) == scheduleFields {
+			padded := make([]string, scheduleFields)
+			for j, field := range fe.schedule {
+				padded[j] = pad(field, widths[j])
+			}
+			schedules[i] = strings.Join(padded, " ")
+		} else {
+			schedules[i] = fe.schedule[0]
+		}
+		scheduleWidth = max(scheduleWidth, len(schedules[i]))
+		userWidth = max(userWidth, len(fe.user))
+	}
+	for i, fe := range block {
+		out.WriteString(pad(schedules[i], scheduleWidth))
+		out.WriteByte(' ')
+		if fe.user != "" {
+			out.WriteString(pad(fe.user, userWidth))
+			out.WriteByte(' ')
+		}
+		out.WriteString(fe.command)
+		out.WriteByte('\n')
+	}
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-len(s))
+}
+
+// canonicalField returns the canonical spelling of the schedule field at
+// index i: 0 minute, 1 hour, 2 day of month, 3 month, 4 day of week.
+func canonicalField(i int, s string) string {
+	s = strings.ToUpper(s)
+	if s == "?" {
+		return "*"
+	}
+	items := strings.Split(s, ",")
+	for j, item := range items {
+		// 5/1 means 5 through the maximum, so only * and ranges lose a step
+		// of 1.
+		if base, ok := strings.CutSuffix(item, "/1"); ok && (base == "*" || strings.Contains(base, "-")) {
+			item = base
+		}
+		if i == 4 && item == "7" {
+			item = "0"
+		}
+		items[j] = item
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,57 @@
+package crontab_test
+
+import (
+	"testing"
+
+	"github.com/toba/cronexpr/crontab"
+)
+
+func TestFormat(t *testing.T) {
+	const src = "# nightly jobs  \n" +
+		"MAILTO=ops@example.com\n" +
+		"*/1 9-17/1 ? jan mon-fri   poll --verbose  \n" +
+		"@annually rotate\n" +
+		"0 0 * * 7 mail -s hi ops%body\\%\n" +
+		"\n" +
+		"0 9 * * MON#9 broken\n" +
+		"5/1 0 * * * tail\n" +
+		"@MIDNIGHT backup\n"
+	const want = "# nightly jobs\n" +
+		"MAILTO=ops@example.com\n" +
+		"* 9-17 * JAN MON-FRI poll --verbose\n" +
+		"@yearly              rotate\n" +
+		"0 0    * *   0       mail -s hi ops%body\\%\n" +
+		"\n" +
+		"0 9 * * MON#9 broken\n" +
+		"5/1 0 * * * tail\n" +
+		"@daily      backup\n"
+	got, err := crontab.Format([]byte(src), crontab.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+
+	again, err := crontab.Format(got, crontab.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(got) {
+		t.Errorf("Format() is not idempotent:\n%s", again)
+	}
+}
+
+func TestFormat_System(t *testing.T) {
+	const src = "17 * * * * root run-parts /etc/cron.hourly\n" +
+		"@weekly   nobody /bin/true\n"
+	const want = "17 * * * * root   run-parts /etc/cron.hourly\n" +
+		"@weekly    nobody /bin/true\n"
+	got, err := crontab.Format([]byte(src), crontab.System)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
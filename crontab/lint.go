@@ -0,0 +1,200 @@
+package crontab
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Checks reported by Lint.
+const (
+	// CheckSyntax reports a line that does not parse.
+	CheckSyntax = "syntax"
+	// CheckNeverFires reports a schedule with no matching time, such as
+	// 0 0 30 2 *.
+	CheckNeverFires = "never-fires"
+	// CheckDuplicate reports an entry repeating the schedule, user and
+	// command of an earlier one.
+	CheckDuplicate = "duplicate"
+	// CheckDayUnion reports a schedule restricting both day of month and day
+	// of week, which runs when either matches rather than both.
+	CheckDayUnion = "dom-dow-union"
+	// CheckDSTGap reports a schedule with times skipped when clocks spring
+	// forward.
+	CheckDSTGap = "dst-gap"
+	// CheckEveryMinute reports * in the minute field with a fixed hour, which
+	// runs every minute of that hour.
+	CheckEveryMinute = "every-minute"
+)
+
+// Problem is a finding of Lint.
+type Problem struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%d:%d: %s (%s)", p.Line, p.Column, p.Message, p.Check)
+}
+
+// LintOption configures Lint.
+type LintOption func(*lintConfig)
+
+type lintConfig struct {
+	loc *time.Location
+	now time.Time
+}
+
+// WithLocation sets the time zone cron runs in, used for entries without a
+// CRON_TZ assignment. The default is time.Local.
+func WithLocation(loc *time.Location) LintOption {
+	return func(cfg *lintConfig) {
+		cfg.loc = loc
+	}
+}
+
+// WithTime sets the start of the year searched for daylight saving gaps. The
+// default is the current time.
+func WithTime(now time.Time) LintOption {
+	return func(cfg *lintConfig) {
+		cfg.now = now
+	}
+}
+
+// Lint checks a parsed crontab and returns its problems ordered by position,
+// starting with the diagnostics from Parse.
+func Lint(f *File, opts ...LintOption) []Problem {
+	cfg := lintConfig{loc: time.Local}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.now.IsZero() {
+		cfg.now = time.Now()
+	}
+
+	var problems []Problem
+	report := func(line, column int, check, format string, args ...any) {
+		problems = append(problems, Problem{line, column, check, fmt.Sprintf(format, args...)})
+	}
+	for _, d := range f.Diagnostics {
+		report(d.Line, d.Column, CheckSyntax, "%v", d.Err)
+	}
+
+	seen := make(map[string]int) // duplicate key to first line
+	for _, e := range f.Entries {
+		key := duplicateKey(e)
+		if first, ok := seen[key]; ok {
+			report(e.Line, e.Schedule[0].Column, CheckDuplicate, "duplicates the entry on line %d", first)
+		} else {
+			seen[key] = e.Line
+		}
+		if e.Reboot {
+			continue
+		}
+
+		if e.Expr.Next(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+			report(e.Line, e.Schedule[0].Column, CheckNeverFires, "schedule never fires")
+			continue
+		}
+
+		if len(e.Schedule) == scheduleFields {
+			minute, hour := e.Schedule[0], e.Schedule[1]
+			dom, dow := e.Schedule[2], e.Schedule[4]
+			domText, dowText := canonicalField(2, dom.Text), canonicalField(4, dow.Text)
+			if domText != "*" && dowText != "*" {
+				report(e.Line, dom.Column, CheckDayUnion,
+					"runs when the day of month is %s or the day of week is %s, not only when both match", dom.Text, dow.Text)
+			}
+			if canonicalField(0, minute.Text) == "*" && !strings.Contains(hour.Text, "*") {
+				report(e.Line, minute.Column, CheckEveryMinute,
+					"* in the minute field runs every minute of hour %s; use a single minute such as 0 to run once", hour.Text)
+			}
+		}
+
+		loc := cmp.Or(e.Location, cfg.loc)
+		if at, ok := firstInGap(e, loc, cfg.now); ok {
+			column := e.Schedule[0].Column
+			if len(e.Schedule) == scheduleFields {
+				column = e.Schedule[1].Column
+			}
+			report(e.Line, column, CheckDSTGap, "runs at %s, which is skipped when clocks spring forward on %s in %s",
+				at.Format("15:04"), at.Format("2006-01-02"), loc)
+		}
+	}
+
+	slices.SortStableFunc(problems, func(a, b Problem) int {
+		return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+	return problems
+}
+
+// WriteJSON writes problems to w as a JSON array, for consumption by CI.
+func WriteJSON(w io.Writer, problems []Problem) error {
+	if problems == nil {
+		problems = []Problem{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(problems)
+}
+
+// duplicateKey identifies the entries that run the same command as the same
+// user at the same times.
+func duplicateKey(e *Entry) string {
+	schedule := "@reboot"
+	if !e.Reboot {
+		if len(e.Schedule) == 1 {
+			schedule = expansions[aliases[strings.ToLower(e.Schedule[0].Text)]]
+		} else {
+			fields := make([]string, len(e.Schedule))
+			for i, f := range e.Schedule {
+				fields[i] = canonicalField(i, f.Text)
+			}
+			schedule = strings.Join(fields, " ")
+		}
+	}
+	var zone string
+	if e.Location != nil {
+		zone = e.Location.String()
+	}
+	return strings.Join([]string{schedule, zone, e.User.Text, e.Command, e.Stdin}, "\x00")
+}
+
+// firstInGap returns the first wall time matched by e that falls in a
+// daylight saving gap of loc during the year after now.
+func firstInGap(e *Entry, loc *time.Location, now time.Time) (time.Time, bool) {
+	const step = time.Hour
+	end := now.AddDate(1, 0, 0)
+	for t := now; t.Before(end); t = t.Add(step) {
+		_, before := t.In(loc).Zone()
+		_, after := t.Add(step).In(loc).Zone()
+		if after <= before {
+			continue
+		}
+		// Find the instant of the transition.
+		lo, hi := t, t.Add(step)
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, off := mid.In(loc).Zone(); off == before {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		// Evaluate the schedule on wall times, expressed in UTC, so the gap
+		// between the old and new wall clock can be searched directly.
+		w := hi.In(loc)
+		gapEnd := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, time.UTC)
+		gapStart := gapEnd.Add(-time.Duration(after-before) * time.Second)
+		if next := e.Expr.Next(gapStart.Add(-time.Second)); !next.IsZero() && next.Before(gapEnd) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,79 @@
+package crontab_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr/crontab"
+)
+
+func TestLint(t *testing.T) {
+	const src = "0 0 30 2 * never\n" + // 1
+		"0 3 * * * backup\n" + // 2
+		"0 3 * * * backup\n" + // 3
+		"@daily report\n" + // 4
+		"0 0 * * * report\n" + // 5
+		"0 0 13 * 5 friday13\n" + // 6
+		"* 9 * * * oops\n" + // 7
+		"*/5 9 * * * fine\n" + // 8
+		"30 2 * * * gap\n" + // 9
+		"CRON_TZ=UTC\n" + // 10
+		"45 2 * * * nogap\n" + // 11
+		"0 9 * * MON#9 broken\n" // 12
+	f, err := crontab.Parse(strings.NewReader(src), crontab.User)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := crontab.Lint(f, crontab.WithLocation(ny), crontab.WithTime(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	want := []crontab.Problem{
+		{Line: 1, Column: 1, Check: crontab.CheckNeverFires},
+		{Line: 3, Column: 1, Check: crontab.CheckDuplicate, Message: "duplicates the entry on line 2"},
+		{Line: 5, Column: 1, Check: crontab.CheckDuplicate, Message: "duplicates the entry on line 4"},
+		{Line: 6, Column: 5, Check: crontab.CheckDayUnion},
+		{Line: 7, Column: 1, Check: crontab.CheckEveryMinute},
+		{Line: 9, Column: 4, Check: crontab.CheckDSTGap, Message: "runs at 02:30, which is skipped when clocks spring forward on 2027-03-14 in America/New_York"},
+		{Line: 12, Column: 9, Check: crontab.CheckSyntax},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Lint() = %v, want %d problems", got, len(want))
+	}
+	for i, p := range got {
+		w := want[i]
+		if p.Line != w.Line || p.Column != w.Column || p.Check != w.Check || (w.Message != "" && p.Message != w.Message) {
+			t.Errorf("problem %d = %v, want %v", i, p, w)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := crontab.WriteJSON(&buf, got); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []crontab.Problem
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != len(got) || decoded[5] != got[5] {
+		t.Errorf("WriteJSON round trip = %v", decoded)
+	}
+	if !strings.Contains(buf.String(), `"check": "dst-gap"`) {
+		t.Errorf("WriteJSON() = %s", buf.String())
+	}
+}
+
+func TestWriteJSON_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := crontab.WriteJSON(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "[]" {
+		t.Errorf("WriteJSON(nil) = %s, want []", got)
+	}
+}
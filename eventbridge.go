@@ -0,0 +1,227 @@
+package cronexpr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// eventBridgeFields are the fields of an EventBridge cron() expression with
+// the numeric ranges AWS accepts. Years past 2099 are valid in AWS but beyond
+// what Expression supports.
+var eventBridgeFields = []struct {
+	name   string
+	lo, hi int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 1, 7},
+	{"year", minYear, maxYear},
+}
+
+// ParseEventBridge parses an Amazon EventBridge schedule expression, either
+// cron(minutes hours day-of-month month day-of-week year) or
+// rate(value unit).
+//
+// cron() expressions follow the AWS rules rather than those of Parse: all
+// six fields are required, days of the week run from 1 (SUN) to 7 (SAT), and
+// exactly one of the day-of-month and day-of-week fields must be ?. L, W, LW
+// and # are supported as in Parse. Errors are of type *ParseError, located in
+// s.
+//
+// rate() expressions take a unit of minute, hour or day, singular for a value
+// of 1 and plural otherwise. AWS counts the interval from when the rule is
+// created; the returned Expression fires at multiples of it since the Unix
+// epoch, so rate(5 minutes) runs at :00, :05 and so on.
+func ParseEventBridge(s string) (*Expression, error) {
+	trimmed := strings.TrimSpace(s)
+	offset := strings.Index(s, trimmed)
+	invalid := func(start, end int, format string, args ...any) error {
+		return &ParseError{Input: s, Offset: start, Length: end - start, Msg: fmt.Sprintf(format, args...)}
+	}
+	kind, _, _ := strings.Cut(trimmed, "(")
+	if (kind != "cron" && kind != "rate") || !strings.HasSuffix(trimmed, ")") {
+		return nil, invalid(offset, offset+len(trimmed), "schedule expression must be cron(...) or rate(...)")
+	}
+	innerStart := offset + len(kind) + 1
+	inner := s[innerStart : offset+len(trimmed)-1]
+	if kind == "rate" {
+		return parseEventBridgeRate(s, inner, innerStart)
+	}
+
+	fields := splitFields(inner)
+	if len(fields) != len(eventBridgeFields) {
+		return nil, invalid(innerStart, innerStart+len(inner), "cron() needs 6 fields (minutes hours day-of-month month day-of-week year), got %d", len(fields))
+	}
+	dom, dow := fields[2], fields[4]
+	if (dom.text == "?") == (dow.text == "?") {
+		return nil, invalid(innerStart+dom.start, innerStart+dow.end, "exactly one of day-of-month and day-of-week must be ?")
+	}
+
+	// converted holds the fields in the form Parse accepts, led by seconds.
+	converted := []string{"0"}
+	for i, f := range fields {
+		text := f.text
+		if text == "?" && (i == 2 || i == 4) {
+			converted = append(converted, "*")
+			continue
+		}
+		d := eventBridgeFields[i]
+		if strings.Contains(text, "?") {
+			return nil, invalid(innerStart+f.start, innerStart+f.end, "? is only allowed alone in the day-of-month or day-of-week field")
+		}
+		for _, e := range splitEntries(text) {
+			if err := checkEventBridgeEntry(e.text, i, d.lo, d.hi); err != nil {
+				return nil, invalid(innerStart+f.start+e.start, innerStart+f.start+e.end, "%s: %v", d.name, err)
+			}
+		}
+		if i == 4 {
+			text = eventBridgeWeekdays(text)
+		} else if base, step, ok := strings.Cut(text, "/"); ok && base == strconv.Itoa(d.lo) {
+			// 0/15 is the AWS spelling of */15.
+			text = "*/" + step
+		}
+		converted = append(converted, text)
+	}
+
+	normalized := strings.Join(converted, " ")
+	expr, err := Parse(normalized)
+	if err != nil {
+		// Locate the field Parse rejected within s.
+		var pe *ParseError
+		field := fields[0]
+		if errors.As(err, &pe) {
+			pos := len(converted[0]) + 1
+			for i, c := range converted[1:] {
+				if pe.Offset < pos+len(c) {
+					field = fields[i]
+					break
+				}
+				pos += len(c) + 1
+			}
+		}
+		return nil, invalid(innerStart+field.start, innerStart+field.end, "%v", err)
+	}
+	return expr, nil
+}
+
+// checkEventBridgeEntry checks the numbers of one comma-separated entry of
+// field i against [lo, hi], leaving names, L, W and # to Parse. The week after
+// # and step values after / are not field values.
+func checkEventBridgeEntry(entry string, i, lo, hi int) error {
+	if strings.ContainsAny(entry, "LW#") && (i == 0 || i == 1 || i == 5) {
+		return fmt.Errorf("%q: L, W and # are only allowed in the day fields", entry)
+	}
+	base, _, _ := strings.Cut(entry, "/")
+	base, _, _ = strings.Cut(base, "#")
+	for part := range strings.SplitSeq(base, "-") {
+		part = strings.TrimRight(part, "LW")
+		if part == "" || part == "*" || !unicode.IsDigit(rune(part[0])) {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < lo || n > hi {
+			return fmt.Errorf("%q is not in %d-%d", part, lo, hi)
+		}
+	}
+	return nil
+}
+
+// eventBridgeWeekdays renumbers a day-of-week field from 1-7 (SUN-SAT) to the
+// 0-6 of Parse. A lone L, the last day of the week, is Saturday.
+func eventBridgeWeekdays(field string) string {
+	entries := strings.Split(field, ",")
+	for i, e := range entries {
+		if e == "L" {
+			entries[i] = "6"
+			continue
+		}
+		var b strings.Builder
+		for j := 0; j < len(e); j++ {
+			c := e[j]
+			if c >= '1' && c <= '7' && (j == 0 || e[j-1] != '#' && e[j-1] != '/' && !unicode.IsDigit(rune(e[j-1]))) &&
+				(j+1 == len(e) || !unicode.IsDigit(rune(e[j+1]))) {
+				c--
+			}
+			b.WriteByte(c)
+		}
+		entries[i] = b.String()
+	}
+	return strings.Join(entries, ",")
+}
+
+// eventBridgeUnits are the rate() units with their durations.
+var eventBridgeUnits = map[string]time.Duration{
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+}
+
+// parseEventBridgeRate parses the "value unit" of a rate() expression found
+// at offset start of s.
+func parseEventBridgeRate(s, inner string, start int) (*Expression, error) {
+	invalid := func(format string, args ...any) error {
+		return &ParseError{Input: s, Offset: start, Length: len(inner), Msg: fmt.Sprintf(format, args...)}
+	}
+	fields := strings.Fields(inner)
+	if len(fields) != 2 {
+		return nil, invalid("rate() needs a value and a unit, such as rate(5 minutes)")
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil || n < 1 {
+		return nil, invalid("rate() value %q is not a positive whole number", fields[0])
+	}
+	unit := fields[1]
+	singular := strings.TrimSuffix(unit, "s")
+	d, ok := eventBridgeUnits[singular]
+	switch {
+	case !ok:
+		return nil, invalid("rate() unit %q is not minute(s), hour(s) or day(s)", unit)
+	case n == 1 && unit != singular:
+		return nil, invalid("rate() unit must be singular for a value of 1: %s", singular)
+	case n > 1 && unit == singular:
+		return nil, invalid("rate() unit must be plural for a value greater than 1: %ss", singular)
+	}
+	return &Expression{normalized: "rate(" + fields[0] + " " + unit + ")", interval: time.Duration(n) * d}, nil
+}
+
+// nextInterval returns the first multiple of the interval since the Unix
+// epoch after fromTime, in its location.
+func (expr *Expression) nextInterval(fromTime time.Time) time.Time {
+	step := int64(expr.interval / time.Second)
+	k := fromTime.Unix() / step
+	if fromTime.Unix() < 0 && fromTime.Unix()%step != 0 {
+		k--
+	}
+	return time.Unix((k+1)*step, 0).In(fromTime.Location())
+}
+
+// prevInterval returns the last multiple of the interval since the Unix
+// epoch before fromTime, in its location.
+func (expr *Expression) prevInterval(fromTime time.Time) time.Time {
+	t := expr.nextInterval(fromTime).Add(-expr.interval)
+	if !t.Before(fromTime) {
+		t = t.Add(-expr.interval)
+	}
+	return t
+}
+
+// describeInterval describes a rate() expression in the describer's
+// language.
+func (expr *Expression) describeInterval(d *describer) Description {
+	for _, unit := range []struct {
+		name  string
+		field Field
+	}{{"day", DayOfMonthField}, {"hour", HourField}, {"minute", MinuteField}} {
+		u := eventBridgeUnits[unit.name]
+		if expr.interval%u == 0 {
+			return d.every("every-"+unit.name+"s", unit.field, int(expr.interval/u))
+		}
+	}
+	return Description{textPart(expr.interval.String())}
+}
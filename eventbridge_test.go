@@ -0,0 +1,128 @@
+package cronexpr_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestParseEventBridge(t *testing.T) {
+	tests := []struct {
+		schedule string
+		want     string
+	}{
+		{"cron(0 12 ? * MON-FRI *)", "0 12 * * 1-5"},
+		{"cron(15 10 * * ? *)", "15 10 * * *"},
+		{"cron(0/15 * * * ? *)", "*/15 * * * *"},
+		{"cron(0 18 ? * 2-6 *)", "0 18 * * 1-5"},
+		{"cron(0 8 ? * 1,7 *)", "0 8 * * 0,6"},
+		{"cron(0 9 ? * 6L *)", "0 9 * * 5L"},
+		{"cron(0 9 ? * 3#2 *)", "0 9 * * 2#2"},
+		{"cron(0 9 ? * L *)", "0 9 * * 6"},
+		{"cron(0 0 L * ? *)", "0 0 L * *"},
+		{"cron(0 0 15W * ? *)", "0 0 15W * *"},
+		{"cron(0 0 LW JUL ? *)", "0 0 LW 7 *"},
+		{"cron(30 6 1 1 ? 2027)", "0 30 6 1 1 * 2027"},
+		{"  cron(0 12 ? * MON *) ", "0 12 * * 1"},
+	}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			expr, err := cronexpr.ParseEventBridge(tt.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := cronexpr.MustParse(tt.want)
+			if got, want := expr.NextN(from, 20), want.NextN(from, 20); !slices.EqualFunc(got, want, time.Time.Equal) {
+				t.Errorf("fires at %v, want %v", got, want)
+			}
+			if got, want := expr.Describe(nil), want.Describe(nil); got != want {
+				t.Errorf("Describe = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseEventBridge_Errors(t *testing.T) {
+	tests := []struct {
+		schedule string
+		offset   int
+		length   int
+	}{
+		{"0 12 * * ?", 0, 10},
+		{"cron(0 12 * * ?)", 5, 10},
+		{"cron(0 12 * * * *)", 10, 5},
+		{"cron(0 12 ? * ? *)", 10, 5},
+		{"cron(0 25 ? * MON *)", 7, 2},
+		{"cron(0 12 ? * 0 *)", 14, 1},
+		{"cron(0 12 ? * 8 *)", 14, 1},
+		{"cron(0 12 32 * ? *)", 10, 2},
+		{"cron(0 L 1 * ? *)", 7, 1},
+		{"cron(0 12 1 * ? 2150)", 16, 4},
+		{"cron(0 12 1 FOO ? *)", 12, 3},
+		{"cron(0 1? 1 * ? *)", 7, 2},
+		{"rate(0 minutes)", 5, 9},
+		{"rate(1 minutes)", 5, 9},
+		{"rate(5 minute)", 5, 8},
+		{"rate(5 weeks)", 5, 7},
+		{"rate(5)", 5, 1},
+	}
+	for _, tt := range tests {
+		_, err := cronexpr.ParseEventBridge(tt.schedule)
+		var pe *cronexpr.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ParseEventBridge(%q) error = %v, want a *ParseError", tt.schedule, err)
+			continue
+		}
+		if pe.Offset != tt.offset || pe.Length != tt.length {
+			t.Errorf("ParseEventBridge(%q) error %q at %d+%d, want %d+%d", tt.schedule, pe.Msg, pe.Offset, pe.Length, tt.offset, tt.length)
+		}
+	}
+}
+
+func TestParseEventBridge_Rate(t *testing.T) {
+	tests := []struct {
+		schedule string
+		from     time.Time
+		next     time.Time
+		desc     string
+	}{
+		{"rate(1 minute)", time.Date(2026, 5, 1, 10, 0, 30, 0, time.UTC), time.Date(2026, 5, 1, 10, 1, 0, 0, time.UTC), "Every minute"},
+		{"rate(5 minutes)", time.Date(2026, 5, 1, 10, 2, 0, 0, time.UTC), time.Date(2026, 5, 1, 10, 5, 0, 0, time.UTC), "Every 5 minutes"},
+		{"rate(7 minutes)", time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 10, 4, 0, 0, time.UTC), "Every 7 minutes"},
+		{"rate(2 hours)", time.Date(2026, 5, 1, 11, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC), "Every 2 hours"},
+		{"rate(1 day)", time.Date(2026, 5, 1, 11, 0, 0, 0, time.UTC), time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), "Every day"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			expr, err := cronexpr.ParseEventBridge(tt.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next := expr.Next(tt.from)
+			if !next.Equal(tt.next) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, next, tt.next)
+			}
+			if prev := expr.Prev(next); !prev.Before(tt.from.Add(time.Second)) || !expr.Next(prev).Equal(next) {
+				t.Errorf("Prev(%v) = %v", next, prev)
+			}
+			if !expr.Matches(next) || expr.Matches(next.Add(time.Second)) {
+				t.Errorf("Matches disagrees with Next at %v", next)
+			}
+			if got := expr.Describe(nil); got != tt.desc {
+				t.Errorf("Describe = %q, want %q", got, tt.desc)
+			}
+		})
+	}
+
+	rate, _ := cronexpr.ParseEventBridge("rate(1 hour)")
+	if _, err := rate.ToRRULE(); !errors.Is(err, cronexpr.ErrNoRRULE) {
+		t.Errorf("ToRRULE of a rate error = %v, want ErrNoRRULE", err)
+	}
+	if got := rate.Calendar(2026, time.February, time.UTC).Total(); got != 28*24 {
+		t.Errorf("Calendar total = %d, want %d", got, 28*24)
+	}
+}
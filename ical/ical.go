@@ -0,0 +1,330 @@
+// Package ical writes cron schedules as RFC 5545 iCalendar streams, so that
+// calendar applications can subscribe to them.
+package ical
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+// DefaultProdID identifies the generator in the PRODID property.
+const DefaultProdID = "-//toba//cronexpr//EN"
+
+// timezoneYears is how far past the start of an event with unbounded rules
+// its VTIMEZONE lists transitions.
+const timezoneYears = 10
+
+// Calendar is an iCalendar object holding recurring events.
+type Calendar struct {
+	// ProdID is the PRODID property; DefaultProdID if empty.
+	ProdID string
+	// Stamp is the DTSTAMP of every event; the current time if zero.
+	Stamp  time.Time
+	Events []Event
+}
+
+// Event is a VEVENT recurring on a cron schedule.
+type Event struct {
+	// UID uniquely identifies the event, such as "backup@example.com".
+	UID         string
+	Summary     string
+	Description string
+	// Recurrence is the schedule, usually from Expression.Recurrence. Its
+	// Start gives DTSTART and the time zone of the event.
+	Recurrence cronexpr.Recurrence
+	// Duration is the length of each occurrence; zero for an instant.
+	Duration time.Duration
+}
+
+// Encode writes the calendar to w. Events in a zone other than UTC get their
+// times with a TZID, defined by a VTIMEZONE listing the zone's transitions
+// over the span of the events. The TZID of time.Local is the name of the
+// zone it was loaded from.
+func (c *Calendar) Encode(w io.Writer) error {
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + cmp.Or(c.ProdID, DefaultProdID))
+	e.line("CALSCALE:GREGORIAN")
+	for _, z := range zones(c.Events) {
+		e.timezone(z)
+	}
+	for _, ev := range c.Events {
+		if ev.Recurrence.Start.IsZero() {
+			continue
+		}
+		e.event(ev, stamp)
+	}
+	e.line("END:VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// zone is a time zone used by the events and the span it must cover.
+type zone struct {
+	loc        *time.Location
+	from, till time.Time
+}
+
+// zones returns the non-UTC zones of events, in order of first use.
+func zones(events []Event) []*zone {
+	var zs []*zone
+	for _, ev := range events {
+		rec := ev.Recurrence
+		if rec.Start.IsZero() || rec.Start.Location() == time.UTC {
+			continue
+		}
+		till := rec.Start
+		if len(rec.RRules) > 0 {
+			till = till.AddDate(timezoneYears, 0, 0)
+		}
+		for _, t := range rec.RDates {
+			till = maxTime(till, t)
+		}
+		i := slices.IndexFunc(zs, func(z *zone) bool { return z.loc.String() == rec.Start.Location().String() })
+		if i < 0 {
+			zs = append(zs, &zone{loc: rec.Start.Location(), from: rec.Start, till: till})
+			continue
+		}
+		zs[i].from = minTime(zs[i].from, rec.Start)
+		zs[i].till = maxTime(zs[i].till, till)
+	}
+	return zs
+}
+
+// encoder writes content lines, folding them at 75 octets, and keeps the
+// first error.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) line(s string) {
+	if e.err != nil {
+		return
+	}
+	// Continuation lines start with a space, leaving 74 octets of content.
+	for limit := 75; len(s) > limit; limit = 74 {
+		// Fold without splitting a UTF-8 sequence.
+		n := limit
+		for n > 0 && s[n]&0xC0 == 0x80 {
+			n--
+		}
+		if _, e.err = e.w.WriteString(s[:n] + "\r\n "); e.err != nil {
+			return
+		}
+		s = s[n:]
+	}
+	_, e.err = e.w.WriteString(s + "\r\n")
+}
+
+func (e *encoder) event(ev Event, stamp time.Time) {
+	rec := ev.Recurrence
+	e.line("BEGIN:VEVENT")
+	e.line("UID:" + escapeText(ev.UID))
+	e.line("DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"))
+	loc := rec.Start.Location()
+	e.line("DTSTART" + tzParam(loc) + ":" + timeValue(loc, rec.Start))
+	if ev.Duration > 0 {
+		e.line("DURATION:" + duration(ev.Duration))
+	}
+	if ev.Summary != "" {
+		e.line("SUMMARY:" + escapeText(ev.Summary))
+	}
+	if ev.Description != "" {
+		e.line("DESCRIPTION:" + escapeText(ev.Description))
+	}
+	for _, r := range rec.RRules {
+		e.line("RRULE:" + r)
+	}
+	if len(rec.RDates) > 0 {
+		values := make([]string, len(rec.RDates))
+		for i, t := range rec.RDates {
+			values[i] = timeValue(loc, t)
+		}
+		e.line("RDATE" + tzParam(loc) + ":" + strings.Join(values, ","))
+	}
+	e.line("END:VEVENT")
+}
+
+// timezone writes a VTIMEZONE with one observance for each transition of
+// the zone between a year before and a year after its span, so clients
+// without the zone database still place every occurrence correctly.
+func (e *encoder) timezone(z *zone) {
+	e.line("BEGIN:VTIMEZONE")
+	e.line("TZID:" + tzid(z.loc))
+	from := z.from.AddDate(-1, 0, 0)
+	till := z.till.AddDate(1, 0, 0)
+	transitions := transitionsBetween(z.loc, from, till)
+	if len(transitions) == 0 {
+		name, offset := from.In(z.loc).Zone()
+		e.observance("STANDARD", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), offset, offset, name)
+	}
+	for _, t := range transitions {
+		_, before := t.Add(-time.Second).In(z.loc).Zone()
+		after := t.In(z.loc)
+		name, offset := after.Zone()
+		kind := "STANDARD"
+		if after.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		// DTSTART is the local time of the transition in the old offset.
+		wall := t.Add(time.Duration(before) * time.Second).UTC()
+		e.observance(kind, wall, before, offset, name)
+	}
+	e.line("END:VTIMEZONE")
+}
+
+func (e *encoder) observance(kind string, wall time.Time, from, to int, name string) {
+	e.line("BEGIN:" + kind)
+	e.line("DTSTART:" + wall.Format("20060102T150405"))
+	e.line("TZOFFSETFROM:" + utcOffset(from))
+	e.line("TZOFFSETTO:" + utcOffset(to))
+	e.line("TZNAME:" + escapeText(name))
+	e.line("END:" + kind)
+}
+
+// transitionsBetween returns the instants in [from, till) at which loc
+// changes its offset or abbreviation.
+func transitionsBetween(loc *time.Location, from, till time.Time) []time.Time {
+	var out []time.Time
+	const step = 6 * time.Hour
+	for t := from; t.Before(till); t = t.Add(step) {
+		name, offset := t.In(loc).Zone()
+		nextName, nextOffset := t.Add(step).In(loc).Zone()
+		if name == nextName && offset == nextOffset {
+			continue
+		}
+		lo, hi := t, t.Add(step)
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if n, o := mid.In(loc).Zone(); n == name && o == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		out = append(out, hi.Truncate(time.Second))
+	}
+	return out
+}
+
+// timeValue formats t as a DATE-TIME value: local time in loc, to go with
+// the TZID parameter from tzParam, or UTC time in UTC.
+func timeValue(loc *time.Location, t time.Time) string {
+	if loc == time.UTC {
+		return t.UTC().Format("20060102T150405Z")
+	}
+	return t.In(loc).Format("20060102T150405")
+}
+
+// tzParam returns the TZID parameter for times in loc; none for UTC.
+func tzParam(loc *time.Location) string {
+	if loc == time.UTC {
+		return ""
+	}
+	return ";TZID=" + tzid(loc)
+}
+
+// tzid returns the zone name identifying loc. time.Local is named "Local",
+// which no client knows, so it is named after the zone it was loaded from:
+// $TZ, or the zoneinfo file /etc/localtime links to.
+func tzid(loc *time.Location) string {
+	if loc != time.Local {
+		return loc.String()
+	}
+	name, set := os.LookupEnv("TZ")
+	name = strings.TrimPrefix(name, ":")
+	switch {
+	case set && name == "":
+		name = "UTC"
+	case !set:
+		if path, err := filepath.EvalSymlinks("/etc/localtime"); err == nil {
+			_, name, _ = strings.Cut(path, "zoneinfo/")
+		}
+	}
+	if name == "" || name == "Local" {
+		return loc.String()
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return loc.String()
+	}
+	return name
+}
+
+func utcOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	s := fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+// duration formats d as an RFC 5545 DURATION value such as PT1H30M.
+func duration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d <= 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	b.WriteByte('P')
+	if days := d / (24 * time.Hour); days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+		d -= days * 24 * time.Hour
+	}
+	if d > 0 {
+		b.WriteByte('T')
+		if h := d / time.Hour; h > 0 {
+			fmt.Fprintf(&b, "%dH", h)
+			d -= h * time.Hour
+		}
+		if m := d / time.Minute; m > 0 {
+			fmt.Fprintf(&b, "%dM", m)
+			d -= m * time.Minute
+		}
+		if s := d / time.Second; s > 0 {
+			fmt.Fprintf(&b, "%dS", s)
+		}
+	}
+	return b.String()
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`, "\r", "")
+
+// escapeText escapes a TEXT property value.
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,129 @@
+package ical_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/ical"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, ny)
+	to := time.Date(2026, 12, 1, 0, 0, 0, 0, ny)
+	cal := ical.Calendar{
+		Stamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Events: []ical.Event{
+			{
+				UID:         "report@example.com",
+				Summary:     "Weekly report; finance, ops",
+				Description: "Runs report.sh\nthen mails it",
+				Recurrence:  cronexpr.MustParse("0 17 * * 5L").Recurrence(from, to),
+				Duration:    90 * time.Minute,
+			},
+			{
+				UID:        "payroll@example.com",
+				Summary:    "Payroll",
+				Recurrence: cronexpr.MustParse("0 9 15W * *").Recurrence(from, to),
+			},
+			{
+				UID:        "utc@example.com",
+				Recurrence: cronexpr.MustParse("0 0 * * *").Recurrence(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Time{}),
+			},
+		},
+	}
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:" + ical.DefaultProdID + "\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20261101T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n",
+		"UID:report@example.com\r\nDTSTAMP:20261018T120000Z\r\nDTSTART;TZID=America/New_York:20261030T170000\r\nDURATION:PT1H30M\r\n",
+		"SUMMARY:Weekly report\\; finance\\, ops\r\n",
+		"DESCRIPTION:Runs report.sh\\nthen mails it\r\n",
+		"RRULE:FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0\r\n",
+		"DTSTART;TZID=America/New_York:20261015T090000\r\nSUMMARY:Payroll\r\nRDATE;TZID=America/New_York:20261015T090000,20261116T090000\r\n",
+		"DTSTART:20261001T000000Z\r\nRRULE:FREQ=DAILY;BYHOUR=0;BYMINUTE=0\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, "BEGIN:VTIMEZONE"); n != 1 {
+		t.Errorf("got %d VTIMEZONEs, want 1", n)
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+}
+
+func TestCalendar_EncodeLocal(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	t.Setenv("TZ", "America/New_York")
+	cal := ical.Calendar{
+		Stamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Events: []ical.Event{{
+			UID:        "local@example.com",
+			Recurrence: cronexpr.MustParse("0 9 * * *").Recurrence(time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local), time.Time{}),
+			// Rounds to no time at all.
+			Duration: 300 * time.Millisecond,
+		}},
+	}
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+		"DTSTART;TZID=America/New_York:",
+		"DURATION:PT0S\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Local") {
+		t.Errorf("output names the Local zone:\n%s", out)
+	}
+}
+
+func TestCalendar_EncodeFolding(t *testing.T) {
+	cal := ical.Calendar{
+		Stamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Events: []ical.Event{{
+			UID:         "long@example.com",
+			Description: strings.Repeat("é", 100),
+			Recurrence:  cronexpr.MustParse("@daily").Recurrence(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}),
+		}},
+	}
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if !strings.Contains(unfolded, "DESCRIPTION:"+strings.Repeat("é", 100)+"\r\n") {
+		t.Errorf("unfolded output lacks the description:\n%s", buf.String())
+	}
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+}
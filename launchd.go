@@ -0,0 +1,364 @@
+package cronexpr
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrNoLaunchd is returned by ToLaunchd for expressions that no set of
+// launchd calendar intervals reproduces exactly.
+var ErrNoLaunchd = errors.New("no equivalent launchd calendar interval")
+
+// CalendarInterval is one StartCalendarInterval dictionary of a launchd job.
+// Its keys are Minute, Hour, Day, Weekday and Month; a missing key matches
+// any value, as in launchd.plist(5).
+type CalendarInterval map[string]int
+
+// launchdKey is a CalendarInterval key with its range and the index of the
+// matching field of a five-field cron expression.
+type launchdKey struct {
+	name   string
+	lo, hi int
+	field  int
+}
+
+// maxLaunchdIntervals is the most dictionaries ToLaunchd returns.
+const maxLaunchdIntervals = 1000
+
+// launchdKeys are the CalendarInterval keys in plist order.
+var launchdKeys = []launchdKey{
+	{"Minute", 0, 59, 0},
+	{"Hour", 0, 23, 1},
+	{"Day", 1, 31, 2},
+	{"Weekday", 0, 7, 4},
+	{"Month", 1, 12, 3},
+}
+
+// ToLaunchd converts the cron expression to launchd calendar intervals.
+// launchd has no steps or ranges, so each combination of listed values
+// becomes its own dictionary: 0 9,17 * * 1-5 gives ten. Restricting both day
+// of month and day of week gives dictionaries for each, whose union is the
+// cron schedule.
+//
+// Expressions with seconds, a year field, L, W or #, and rate() schedules,
+// have no equivalent and return an error wrapping ErrNoLaunchd. So do
+// expressions needing more than 1000 dictionaries, such as
+// */1 0-22 1-30 1-11 *, which launchd would not load in practice.
+func (expr *Expression) ToLaunchd() ([]CalendarInterval, error) {
+	switch {
+	case expr.interval > 0:
+		return nil, fmt.Errorf("%w: rate() interval", ErrNoLaunchd)
+	case !slices.Equal(expr.secondList, []int{0}):
+		return nil, fmt.Errorf("%w: seconds field", ErrNoLaunchd)
+	case !slices.Equal(expr.yearList, yearDefaultList):
+		return nil, fmt.Errorf("%w: year field", ErrNoLaunchd)
+	case expr.lastDayOfMonth:
+		return nil, fmt.Errorf("%w: last day (L) in day-of-month field", ErrNoLaunchd)
+	case len(expr.workdaysOfMonth) > 0 || expr.lastWorkdayOfMonth:
+		return nil, fmt.Errorf("%w: nearest weekday (W) in day-of-month field", ErrNoLaunchd)
+	case len(expr.lastWeekDaysOfWeek) > 0:
+		return nil, fmt.Errorf("%w: last weekday of month (L) in day-of-week field", ErrNoLaunchd)
+	case len(expr.specificWeekDaysOfWeek) > 0:
+		return nil, fmt.Errorf("%w: n-th weekday (#) in day-of-week field", ErrNoLaunchd)
+	}
+
+	// base holds the keys shared by every dictionary; a nil list is a
+	// wildcard and gets no key.
+	base := map[string][]int{}
+	if len(expr.minuteList) < 60 {
+		base["Minute"] = expr.minuteList
+	}
+	if len(expr.hourList) < 24 {
+		base["Hour"] = expr.hourList
+	}
+	if len(expr.monthList) < 12 {
+		base["Month"] = expr.monthList
+	}
+	var dayKeys []map[string][]int
+	if expr.daysOfMonthRestricted {
+		dayKeys = append(dayKeys, map[string][]int{"Day": toList(expr.daysOfMonth)})
+	}
+	if expr.daysOfWeekRestricted {
+		dayKeys = append(dayKeys, map[string][]int{"Weekday": toList(expr.daysOfWeek)})
+	}
+	if len(dayKeys) == 0 {
+		dayKeys = append(dayKeys, nil)
+	}
+
+	total := 0
+	for _, days := range dayKeys {
+		n := 1
+		for _, k := range launchdKeys {
+			if values, ok := days[k.name]; ok {
+				n *= len(values)
+			} else if values, ok := base[k.name]; ok {
+				n *= len(values)
+			}
+		}
+		total += n
+	}
+	if total > maxLaunchdIntervals {
+		return nil, fmt.Errorf("%w: %d dictionaries, more than %d", ErrNoLaunchd, total, maxLaunchdIntervals)
+	}
+
+	out := make([]CalendarInterval, 0, total)
+	for _, days := range dayKeys {
+		lists := maps.Clone(base)
+		maps.Copy(lists, days)
+		combos := []CalendarInterval{{}}
+		for _, k := range launchdKeys {
+			values, ok := lists[k.name]
+			if !ok {
+				continue
+			}
+			next := make([]CalendarInterval, 0, len(combos)*len(values))
+			for _, c := range combos {
+				for _, v := range values {
+					d := maps.Clone(c)
+					d[k.name] = v
+					next = append(next, d)
+				}
+			}
+			combos = next
+		}
+		out = append(out, combos...)
+	}
+	return out, nil
+}
+
+// FromLaunchd converts launchd calendar intervals to a cron expression. As
+// in cron, a dictionary with both Day and Weekday runs on days matching
+// either, and Weekday 7 is Sunday.
+//
+// The dictionaries must form a cron schedule: every combination of the
+// values they list, with dictionaries keyed by Day and by Weekday sharing
+// their other values. Sets no single expression reproduces, such as 9:00 and
+// 17:30 without 9:30 and 17:00, return an error wrapping ErrNoCron.
+func FromLaunchd(intervals []CalendarInterval) (*Expression, error) {
+	if len(intervals) == 0 {
+		return nil, errors.New("no calendar intervals")
+	}
+	// groups collects the dictionaries by which day keys they have.
+	groups := map[string][]CalendarInterval{}
+	for i, ci := range intervals {
+		d := CalendarInterval{}
+		for key, v := range ci {
+			j := slices.IndexFunc(launchdKeys, func(k launchdKey) bool { return k.name == key })
+			if j < 0 {
+				return nil, fmt.Errorf("calendar interval %d: unknown key %q", i, key)
+			}
+			if k := launchdKeys[j]; v < k.lo || v > k.hi {
+				return nil, fmt.Errorf("calendar interval %d: %s %d is not in %d..%d", i, key, v, k.lo, k.hi)
+			}
+			if key == "Weekday" && v == 7 {
+				v = 0
+			}
+			d[key] = v
+		}
+		_, day := d["Day"]
+		_, weekday := d["Weekday"]
+		kind := ""
+		if day {
+			kind = "Day"
+		}
+		if weekday {
+			kind += "Weekday"
+		}
+		groups[kind] = append(groups[kind], d)
+	}
+
+	fields := map[string][]string{}
+	for kind, group := range groups {
+		f, err := launchdFields(group)
+		if err != nil {
+			return nil, err
+		}
+		fields[kind] = f
+	}
+	if len(groups) == 1 {
+		for _, f := range fields {
+			return Parse(strings.Join(f, " "))
+		}
+	}
+	// Dictionaries keyed by Day and by Weekday are the two sides of a cron
+	// day union when they agree on everything else.
+	dom, dow := fields["Day"], fields["Weekday"]
+	if len(groups) != 2 || dom == nil || dow == nil || dom[0] != dow[0] || dom[1] != dow[1] || dom[3] != dow[3] {
+		return nil, fmt.Errorf("%w: dictionaries with different day keys do not share their other values", ErrNoCron)
+	}
+	dom[4] = dow[4]
+	return Parse(strings.Join(dom, " "))
+}
+
+// launchdFields returns the cron fields of dictionaries sharing the same keys,
+// checking that they hold every combination of the values they list.
+func launchdFields(intervals []CalendarInterval) ([]string, error) {
+	keys := slices.Sorted(maps.Keys(intervals[0]))
+	sets := map[string]map[int]bool{}
+	seen := map[string]bool{}
+	for _, ci := range intervals {
+		if !slices.Equal(slices.Sorted(maps.Keys(ci)), keys) {
+			return nil, fmt.Errorf("%w: dictionaries with different keys", ErrNoCron)
+		}
+		var tuple []string
+		for _, k := range keys {
+			if sets[k] == nil {
+				sets[k] = map[int]bool{}
+			}
+			sets[k][ci[k]] = true
+			tuple = append(tuple, strconv.Itoa(ci[k]))
+		}
+		seen[strings.Join(tuple, ",")] = true
+	}
+	product := 1
+	for _, set := range sets {
+		product *= len(set)
+	}
+	if len(seen) != product {
+		return nil, fmt.Errorf("%w: %d distinct dictionaries do not cover all %d combinations of their values", ErrNoCron, len(seen), product)
+	}
+	fields := []string{"*", "*", "*", "*", "*"}
+	for _, k := range launchdKeys {
+		if set, ok := sets[k.name]; ok {
+			fields[k.field] = joinInts(toList(set))
+		}
+	}
+	return fields, nil
+}
+
+// MarshalLaunchd returns the StartCalendarInterval key and array of a launchd
+// property list, ready to paste into the job's top-level dictionary.
+func MarshalLaunchd(intervals []CalendarInterval) []byte {
+	var b bytes.Buffer
+	b.WriteString("<key>StartCalendarInterval</key>\n<array>\n")
+	for _, ci := range intervals {
+		b.WriteString("\t<dict>\n")
+		for _, k := range launchdKeys {
+			if v, ok := ci[k.name]; ok {
+				fmt.Fprintf(&b, "\t\t<key>%s</key>\n\t\t<integer>%d</integer>\n", k.name, v)
+			}
+		}
+		b.WriteString("\t</dict>\n")
+	}
+	b.WriteString("</array>\n")
+	return b.Bytes()
+}
+
+// UnmarshalLaunchd reads calendar intervals from XML property list data:
+// the value of the StartCalendarInterval key, which may be a single
+// dictionary or an array of them, or a bare array or dictionary of intervals
+// when the data has no such key. Either may be wrapped in a <plist> element.
+func UnmarshalLaunchd(data []byte) ([]CalendarInterval, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	key := ""
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return unmarshalBareLaunchd(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("launchd plist: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		name := start.Name.Local
+		if name == "key" {
+			if err := dec.DecodeElement(&key, &start); err != nil {
+				return nil, fmt.Errorf("launchd plist: %w", err)
+			}
+			continue
+		}
+		if (name == "array" || name == "dict") && key == "StartCalendarInterval" {
+			return decodeLaunchdIntervals(dec, start)
+		}
+		key = ""
+	}
+}
+
+// unmarshalBareLaunchd reads calendar intervals from property list data
+// without a StartCalendarInterval key, whose outermost element inside any
+// <plist> wrapper is the array or dictionary of intervals.
+func unmarshalBareLaunchd(data []byte) ([]CalendarInterval, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, errors.New("launchd plist: no StartCalendarInterval")
+		}
+		start, ok := tok.(xml.StartElement)
+		switch {
+		case !ok || start.Name.Local == "plist":
+			continue
+		case start.Name.Local == "array" || start.Name.Local == "dict":
+			return decodeLaunchdIntervals(dec, start)
+		}
+		return nil, errors.New("launchd plist: no StartCalendarInterval")
+	}
+}
+
+// decodeLaunchdIntervals decodes an array of dictionaries, or a single
+// dictionary, of integer values.
+func decodeLaunchdIntervals(dec *xml.Decoder, start xml.StartElement) ([]CalendarInterval, error) {
+	type entry struct {
+		Keys   []string `xml:"key"`
+		Values []struct {
+			XMLName xml.Name
+			Text    string `xml:",chardata"`
+		} `xml:",any"`
+	}
+	decodeDict := func(start xml.StartElement) (CalendarInterval, error) {
+		var e entry
+		if err := dec.DecodeElement(&e, &start); err != nil {
+			return nil, fmt.Errorf("launchd plist: %w", err)
+		}
+		if len(e.Keys) != len(e.Values) {
+			return nil, errors.New("launchd plist: dict keys and values do not pair up")
+		}
+		ci := CalendarInterval{}
+		for i, k := range e.Keys {
+			v := e.Values[i]
+			n, err := strconv.Atoi(strings.TrimSpace(v.Text))
+			if v.XMLName.Local != "integer" || err != nil {
+				return nil, fmt.Errorf("launchd plist: %s is not an integer", k)
+			}
+			ci[k] = n
+		}
+		return ci, nil
+	}
+	if start.Name.Local == "dict" {
+		ci, err := decodeDict(start)
+		if err != nil {
+			return nil, err
+		}
+		return []CalendarInterval{ci}, nil
+	}
+	var out []CalendarInterval
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("launchd plist: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "dict" {
+				return nil, fmt.Errorf("launchd plist: <%s> in calendar interval array", t.Name.Local)
+			}
+			ci, err := decodeDict(t)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ci)
+		case xml.EndElement:
+			return out, nil
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package cronexpr_test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestToLaunchd(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []cronexpr.CalendarInterval
+	}{
+		{"* * * * *", []cronexpr.CalendarInterval{{}}},
+		{"30 9 * * *", []cronexpr.CalendarInterval{{"Minute": 30, "Hour": 9}}},
+		{"0 9,17 * * 1-2", []cronexpr.CalendarInterval{
+			{"Minute": 0, "Hour": 9, "Weekday": 1},
+			{"Minute": 0, "Hour": 9, "Weekday": 2},
+			{"Minute": 0, "Hour": 17, "Weekday": 1},
+			{"Minute": 0, "Hour": 17, "Weekday": 2},
+		}},
+		{"0 0 1 */6 *", []cronexpr.CalendarInterval{
+			{"Minute": 0, "Hour": 0, "Day": 1, "Month": 1},
+			{"Minute": 0, "Hour": 0, "Day": 1, "Month": 7},
+		}},
+		{"0 0 13 * 5", []cronexpr.CalendarInterval{
+			{"Minute": 0, "Hour": 0, "Day": 13},
+			{"Minute": 0, "Hour": 0, "Weekday": 5},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := cronexpr.MustParse(tt.expr).ToLaunchd()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.EqualFunc(got, tt.want, func(a, b cronexpr.CalendarInterval) bool { return maps.Equal(a, b) }) {
+				t.Errorf("ToLaunchd = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 0 L * *", "0 0 15W * *", "0 0 * * 5L", "0 0 * * 1#2", "30 0 0 * * * *", "0 0 1 1 * 2030", "*/1 0-22 1-30 1-11 *"} {
+		if _, err := cronexpr.MustParse(expr).ToLaunchd(); !errors.Is(err, cronexpr.ErrNoLaunchd) {
+			t.Errorf("ToLaunchd(%q) error = %v, want ErrNoLaunchd", expr, err)
+		}
+	}
+}
+
+func TestFromLaunchd(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, src := range []string{"* * * * *", "*/20 9-11 * * 1-5", "0 0 13 * 5", "15 4 1,15 3,9 *"} {
+		expr := cronexpr.MustParse(src)
+		intervals, err := expr.ToLaunchd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		back, err := cronexpr.FromLaunchd(intervals)
+		if err != nil {
+			t.Fatalf("FromLaunchd(%q): %v", src, err)
+		}
+		if !slices.EqualFunc(back.NextN(from, 50), expr.NextN(from, 50), time.Time.Equal) {
+			t.Errorf("%q does not round-trip", src)
+		}
+	}
+
+	sunday, err := cronexpr.FromLaunchd([]cronexpr.CalendarInterval{{"Minute": 0, "Hour": 8, "Weekday": 7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sunday.Next(from); got.Weekday() != time.Sunday || got.Hour() != 8 {
+		t.Errorf("Weekday 7 fires at %v, want a Sunday at 8:00", got)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		intervals []cronexpr.CalendarInterval
+		noCron    bool
+	}{
+		{"Empty", nil, false},
+		{"UnknownKey", []cronexpr.CalendarInterval{{"Second": 0}}, false},
+		{"OutOfRange", []cronexpr.CalendarInterval{{"Hour": 24}}, false},
+		{"NotProduct", []cronexpr.CalendarInterval{{"Minute": 0, "Hour": 9}, {"Minute": 30, "Hour": 17}}, true},
+		{"MixedKeys", []cronexpr.CalendarInterval{{"Minute": 0}, {"Minute": 0, "Hour": 9}}, true},
+		{"UnionDifferentTimes", []cronexpr.CalendarInterval{{"Minute": 0, "Day": 1}, {"Minute": 30, "Weekday": 1}}, true},
+	} {
+		_, err := cronexpr.FromLaunchd(tt.intervals)
+		if err == nil || errors.Is(err, cronexpr.ErrNoCron) != tt.noCron {
+			t.Errorf("%s: error = %v, want ErrNoCron %v", tt.name, err, tt.noCron)
+		}
+	}
+}
+
+func TestMarshalLaunchd(t *testing.T) {
+	intervals, err := cronexpr.MustParse("30 9 * * 1,3").ToLaunchd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(cronexpr.MarshalLaunchd(intervals))
+	want := `<key>StartCalendarInterval</key>
+<array>
+	<dict>
+		<key>Minute</key>
+		<integer>30</integer>
+		<key>Hour</key>
+		<integer>9</integer>
+		<key>Weekday</key>
+		<integer>1</integer>
+	</dict>
+	<dict>
+		<key>Minute</key>
+		<integer>30</integer>
+		<key>Hour</key>
+		<integer>9</integer>
+		<key>Weekday</key>
+		<integer>3</integer>
+	</dict>
+</array>
+`
+	if got != want {
+		t.Errorf("MarshalLaunchd =\n%s\nwant\n%s", got, want)
+	}
+
+	back, err := cronexpr.UnmarshalLaunchd([]byte(got))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(back) != 2 || back[1]["Weekday"] != 3 || back[0]["Hour"] != 9 {
+		t.Errorf("UnmarshalLaunchd = %v", back)
+	}
+}
+
+func TestUnmarshalLaunchd(t *testing.T) {
+	plist := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.example.cleanup</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/usr/local/bin/cleanup</string>
+	</array>
+	<key>StartCalendarInterval</key>
+	<dict>
+		<key>Hour</key>
+		<integer>3</integer>
+		<key>Minute</key>
+		<integer>15</integer>
+	</dict>
+</dict>
+</plist>`
+	got, err := cronexpr.UnmarshalLaunchd([]byte(plist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0]["Hour"] != 3 || got[0]["Minute"] != 15 || len(got[0]) != 2 {
+		t.Errorf("UnmarshalLaunchd = %v", got)
+	}
+
+	for _, bare := range []string{
+		`<array><dict><key>Hour</key><integer>3</integer><key>Minute</key><integer>15</integer></dict></array>`,
+		`<plist version="1.0"><array><dict><key>Hour</key><integer>3</integer><key>Minute</key><integer>15</integer></dict></array></plist>`,
+		`<plist version="1.0"><dict><key>Hour</key><integer>3</integer><key>Minute</key><integer>15</integer></dict></plist>`,
+	} {
+		got, err := cronexpr.UnmarshalLaunchd([]byte(bare))
+		if err != nil {
+			t.Errorf("UnmarshalLaunchd(%q): %v", bare, err)
+		} else if len(got) != 1 || got[0]["Hour"] != 3 || got[0]["Minute"] != 15 || len(got[0]) != 2 {
+			t.Errorf("UnmarshalLaunchd(%q) = %v", bare, got)
+		}
+	}
+
+	for _, bad := range []string{
+		`<plist><dict><key>Label</key><string>x</string></dict></plist>`,
+		`<array><dict><key>Hour</key><string>3</string></dict></array>`,
+		`<array><dict><key>Hour</key><integer>3</integer>`,
+	} {
+		if _, err := cronexpr.UnmarshalLaunchd([]byte(bad)); err == nil {
+			t.Errorf("UnmarshalLaunchd(%q) succeeded", bad)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package cronexpr
+
+import (
+	"fmt"
+	"time"
+)
+
+// Missed returns the instants scheduled after lastRun and up to and including
+// now, in chronological order. At most limit instants are returned, starting
+// with the oldest; a limit of zero or less means no limit.
+//
+// Nothing is missed if lastRun is the zero time, since the schedule has never
+// run. The time.Location of the returned instants is that of lastRun.
+func (expr *Expression) Missed(lastRun, now time.Time, limit int) []time.Time {
+	var missed []time.Time
+	for t := expr.Next(lastRun); !t.IsZero() && !t.After(now); t = expr.Next(t) {
+		missed = append(missed, t)
+		if len(missed) == limit {
+			break
+		}
+	}
+	return missed
+}
+
+// catchUpKind identifies a CatchUpPolicy.
+type catchUpKind int
+
+const (
+	catchUpAll catchUpKind = iota
+	catchUpOnce
+	catchUpSkip
+	catchUpWithin
+)
+
+// CatchUpPolicy decides which missed instants a runner should still run after
+// downtime, in the manner of Quartz misfire instructions and the Kubernetes
+// CronJob startingDeadlineSeconds setting. The zero value is RunAll.
+type CatchUpPolicy struct {
+	kind     catchUpKind
+	deadline time.Duration
+}
+
+var (
+	// RunAll runs every missed instant, oldest first.
+	RunAll = CatchUpPolicy{kind: catchUpAll}
+	// RunOnce runs the most recent missed instant once and drops the rest.
+	RunOnce = CatchUpPolicy{kind: catchUpOnce}
+	// Skip drops all missed instants and waits for the next scheduled one.
+	Skip = CatchUpPolicy{kind: catchUpSkip}
+)
+
+// RunIfWithin returns a policy that runs the most recent missed instant once,
+// but only if no more than deadline has passed since it was scheduled.
+func RunIfWithin(deadline time.Duration) CatchUpPolicy {
+	return CatchUpPolicy{kind: catchUpWithin, deadline: deadline}
+}
+
+// CatchUp returns the instants of expr missed between lastRun and now that
+// the policy says should run, oldest first. limit bounds the result for
+// RunAll as it does for Missed; the other policies return at most one
+// instant.
+func (p CatchUpPolicy) CatchUp(expr *Expression, lastRun, now time.Time, limit int) []time.Time {
+	switch p.kind {
+	case catchUpSkip:
+		return nil
+	case catchUpAll:
+		return expr.Missed(lastRun, now, limit)
+	}
+	if lastRun.IsZero() {
+		return nil
+	}
+	// The latest scheduled instant not after now.
+	latest := expr.Prev(now.Add(time.Nanosecond))
+	if latest.IsZero() || !latest.After(lastRun) {
+		return nil
+	}
+	if p.kind == catchUpWithin && now.Sub(latest) > p.deadline {
+		return nil
+	}
+	return []time.Time{latest.In(lastRun.Location())}
+}
+
+// String returns the policy name, such as "RunOnce" or "RunIfWithin(5m0s)".
+func (p CatchUpPolicy) String() string {
+	switch p.kind {
+	case catchUpOnce:
+		return "RunOnce"
+	case catchUpSkip:
+		return "Skip"
+	case catchUpWithin:
+		return fmt.Sprintf("RunIfWithin(%s)", p.deadline)
+	}
+	return "RunAll"
+}
@@ -0,0 +1,125 @@
+package cronexpr_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestMissed(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	utc := func(y int, m time.Month, d, h, mi int) time.Time {
+		return time.Date(y, m, d, h, mi, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name         string
+		expr         string
+		lastRun, now time.Time
+		limit        int
+		want         []time.Time
+	}{
+		{
+			name:    "NeverRan",
+			expr:    "*/5 * * * *",
+			lastRun: time.Time{},
+			now:     utc(2026, 1, 1, 0, 0),
+		},
+		{
+			name:    "UpToAndIncludingNow",
+			expr:    "*/5 * * * *",
+			lastRun: utc(2026, 1, 1, 0, 0),
+			now:     utc(2026, 1, 1, 0, 15),
+			want:    []time.Time{utc(2026, 1, 1, 0, 5), utc(2026, 1, 1, 0, 10), utc(2026, 1, 1, 0, 15)},
+		},
+		{
+			name:    "Limit",
+			expr:    "*/5 * * * *",
+			lastRun: utc(2026, 1, 1, 0, 0),
+			now:     utc(2026, 1, 2, 0, 0),
+			limit:   2,
+			want:    []time.Time{utc(2026, 1, 1, 0, 5), utc(2026, 1, 1, 0, 10)},
+		},
+		{
+			name:    "LastDayOfMonth",
+			expr:    "0 0 L * *",
+			lastRun: utc(2026, 1, 31, 0, 0),
+			now:     utc(2026, 5, 1, 0, 0),
+			want:    []time.Time{utc(2026, 2, 28, 0, 0), utc(2026, 3, 31, 0, 0), utc(2026, 4, 30, 0, 0)},
+		},
+		{
+			name:    "LastWorkdayOfMonth",
+			expr:    "0 18 LW * *",
+			lastRun: utc(2026, 1, 1, 0, 0),
+			now:     utc(2026, 6, 1, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 30, 18, 0), utc(2026, 2, 27, 18, 0), utc(2026, 3, 31, 18, 0),
+				utc(2026, 4, 30, 18, 0), utc(2026, 5, 29, 18, 0),
+			},
+		},
+		{
+			name:    "SpringForward",
+			expr:    "30 2 * * *",
+			lastRun: time.Date(2026, 3, 6, 2, 30, 0, 0, ny),
+			now:     time.Date(2026, 3, 9, 0, 0, 0, 0, ny),
+			want:    []time.Time{time.Date(2026, 3, 7, 2, 30, 0, 0, ny), time.Date(2026, 3, 8, 3, 30, 0, 0, ny)},
+		},
+		{
+			name:    "FallBack",
+			expr:    "30 1 * * *",
+			lastRun: time.Date(2026, 10, 31, 1, 30, 0, 0, ny),
+			now:     time.Date(2026, 11, 2, 0, 0, 0, 0, ny),
+			want:    []time.Time{time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cronexpr.MustParse(tt.expr).Missed(tt.lastRun, tt.now, tt.limit)
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("Missed(%v, %v, %d) = %v, want %v", tt.lastRun, tt.now, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatchUpPolicy(t *testing.T) {
+	expr := cronexpr.MustParse("0 0 L * *")
+	lastRun := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 4, 30, 6, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	apr := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		policy cronexpr.CatchUpPolicy
+		name   string
+		want   []time.Time
+	}{
+		{cronexpr.RunAll, "RunAll", []time.Time{feb, mar, apr}},
+		{cronexpr.RunOnce, "RunOnce", []time.Time{apr}},
+		{cronexpr.Skip, "Skip", nil},
+		{cronexpr.RunIfWithin(6 * time.Hour), "RunIfWithin(6h0m0s)", []time.Time{apr}},
+		{cronexpr.RunIfWithin(time.Hour), "RunIfWithin(1h0m0s)", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.String(); got != tt.name {
+				t.Errorf("String() = %q, want %q", got, tt.name)
+			}
+			got := tt.policy.CatchUp(expr, lastRun, now, 0)
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("CatchUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("NothingMissed", func(t *testing.T) {
+		if got := cronexpr.RunOnce.CatchUp(expr, apr, now, 0); got != nil {
+			t.Errorf("CatchUp() = %v, want nil", got)
+		}
+	})
+}
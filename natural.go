@@ -0,0 +1,812 @@
+package cronexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Indexes into naturalParser.fields, in the order of a seven-field
+// expression.
+const (
+	natSecond = iota
+	natMinute
+	natHour
+	natDayOfMonth
+	natMonth
+	natDayOfWeek
+	natYear
+)
+
+var natFieldNames = []string{"second", "minute", "hour", "day of the month", "month", "day of the week", "year"}
+
+// natOrdinalWords are the spelled-out ordinals ParseNatural accepts, by value.
+var natOrdinalWords = map[string]int{
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+}
+
+// natShorthands are single words standing for a whole schedule.
+var natShorthands = map[string]string{
+	"hourly":   "0 * * * *",
+	"daily":    "0 0 * * *",
+	"nightly":  "0 0 * * *",
+	"weekly":   "0 0 * * 0",
+	"monthly":  "0 0 1 * *",
+	"yearly":   "0 0 1 1 *",
+	"annually": "0 0 1 1 *",
+	// "quarterly on the 15th" runs on the 15th of January, April, July
+	// and October.
+	"quarterly":    "0 0 1 1,4,7,10 *",
+	"semiannually": "0 0 1 1,7 *",
+	// "twice daily", read with the words after it.
+	"twice": "0 0,12 * * *",
+}
+
+// ParseNatural parses an English description of a schedule, such as "every
+// weekday at 9:30am", "every 15 minutes", "on the last Friday of the month at
+// 6pm", "at noon on the 1st and 15th" or "every Monday in January", into the
+// Expression Parse would build for the equivalent cron expression. It
+// understands the descriptions Describe writes, long and short.
+//
+// Parts left unsaid default as a person would read them: "every 15 minutes"
+// runs every hour of every day, and "every Monday" runs once, at midnight.
+// Schedules cron cannot express, such as "at 9:00 and 17:30", return an
+// error.
+func ParseNatural(s string) (*Expression, error) {
+	p := &naturalParser{toks: natTokens(s)}
+	for p.pos < len(p.toks) {
+		if err := p.clause(); err != nil {
+			return nil, fmt.Errorf("natural schedule %q: %w", s, err)
+		}
+	}
+	if !p.any {
+		return nil, fmt.Errorf("natural schedule %q: no schedule found", s)
+	}
+
+	// A shorthand such as "daily" fills in what the rest leaves out, so
+	// "daily at 9am" runs at 9:00 rather than midnight.
+	if p.shorthand != "" {
+		timeGiven := p.fields[natSecond] != "" || p.fields[natMinute] != "" || p.fields[natHour] != ""
+		for i, v := range strings.Fields(p.shorthand) {
+			field := natMinute + i
+			if p.fields[field] == "" && (field >= natDayOfMonth || !timeGiven) && v != "*" || field == natHour && !timeGiven {
+				p.fields[field] = v
+			}
+		}
+	}
+
+	// Time fields coarser than a finer one given repeat, and the others are
+	// zero: "every 15 minutes" is 0 */15 * and "every Monday" is 0 0 0.
+	finer := false
+	for i := natSecond; i <= natHour; i++ {
+		switch {
+		case p.fields[i] != "":
+			finer = true
+		case finer:
+			p.fields[i] = "*"
+		default:
+			p.fields[i] = "0"
+		}
+	}
+	for i := natDayOfMonth; i <= natYear; i++ {
+		if p.fields[i] == "" {
+			p.fields[i] = "*"
+		}
+	}
+	fields := p.fields[natMinute : natDayOfWeek+1]
+	if p.fields[natSecond] != "0" || p.fields[natYear] != "*" {
+		fields = p.fields[:]
+	}
+	expr, err := Parse(strings.Join(fields, " "))
+	if err != nil {
+		return nil, fmt.Errorf("natural schedule %q: %w", s, err)
+	}
+	return expr, nil
+}
+
+// natTokens lowercases s and splits it into words, with dashes as separate
+// tokens and commas and periods dropped.
+func natTokens(s string) []string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer("a.m.", "am", "p.m.", "pm", "–", " - ", "—", " - ", "-", " - ",
+		",", " ").Replace(s)
+	s = strings.TrimRight(s, ". ")
+	return strings.Fields(s)
+}
+
+// naturalParser consumes the tokens of a natural-language schedule, filling
+// in cron fields.
+type naturalParser struct {
+	toks   []string
+	pos    int
+	fields [natYear + 1]string
+	any    bool // whether any clause was recognized
+	// shorthand is the cron expression of a word such as "daily".
+	shorthand string
+	// between is set after "between", where "and" ends a range of times.
+	between bool
+}
+
+func (p *naturalParser) peek(offset int) string {
+	if p.pos+offset < len(p.toks) {
+		return p.toks[p.pos+offset]
+	}
+	return ""
+}
+
+// accept consumes the next token if it is one of words.
+func (p *naturalParser) accept(words ...string) bool {
+	for _, w := range words {
+		if p.peek(0) == w {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+// set assigns a cron field, refusing to change one already given.
+func (p *naturalParser) set(field int, value string) error {
+	prev := p.fields[field]
+	if (field == natDayOfMonth || field == natDayOfWeek) && prev != "" && prev != "*" && value != "*" {
+		// "on the 1st and last day", "on the first and third Monday"
+		value = prev + "," + value
+	} else if prev != "" && prev != value {
+		return fmt.Errorf("%s given twice", natFieldNames[field])
+	}
+	p.fields[field] = value
+	p.any = true
+	return nil
+}
+
+// ofTheMonth consumes "of the month", "of every month" and the like, or a
+// month name after "of".
+func (p *naturalParser) ofTheMonth() error {
+	if p.peek(0) != "of" {
+		return nil
+	}
+	if _, ok := natMonthName(p.peek(1)); ok {
+		p.pos++
+		return p.months()
+	}
+	if p.peek(1) == "month" || p.peek(2) == "month" {
+		p.pos += 2
+		if p.peek(0) == "month" {
+			p.pos++
+		}
+	}
+	return nil
+}
+
+// clause consumes one phrase.
+func (p *naturalParser) clause() error {
+	tok := p.peek(0)
+	switch tok {
+	case "and", "only", "on", "the", "of", "each", "day":
+		p.pos++
+		return nil
+	case "every":
+		return p.every()
+	case "at", "@":
+		p.pos++
+		if p.accept("minute", "min", "minutes", "mins") {
+			return p.numberList(natMinute)
+		}
+		if p.accept("second", "sec", "seconds", "secs") {
+			if err := p.numberList(natSecond); err != nil {
+				return err
+			}
+			p.accept("of")
+			return nil
+		}
+		return p.times()
+	case "in", "during", "from", "between":
+		p.between = tok == "between"
+		p.pos++
+		if _, ok := natMonthName(p.peek(0)); ok {
+			return p.months()
+		}
+		if _, ok := natDayName(p.peek(0)); ok {
+			return p.weekdays()
+		}
+		if _, err := strconv.Atoi(p.peek(0)); err == nil {
+			return p.years()
+		}
+		if _, _, _, ok := p.peekTime(); ok {
+			return p.times()
+		}
+		if tok == "during" && p.accept("business") {
+			// Business hours are 9:00 through 17:00, Monday–Friday.
+			p.accept("hours")
+			if err := p.set(natHour, "9-17"); err != nil {
+				return err
+			}
+			return p.set(natDayOfWeek, "1-5")
+		}
+		if tok == "during" && p.accept("the") {
+			return p.hours()
+		}
+		return nil
+	case "last":
+		return p.last()
+	case "weekday", "weekdays":
+		p.pos++
+		if p.accept("nearest") {
+			p.accept("to")
+			p.accept("the")
+			n, ok := natOrdinal(p.peek(0))
+			if !ok {
+				return fmt.Errorf("expected a day after %q", "nearest")
+			}
+			p.pos++
+			if err := p.set(natDayOfMonth, strconv.Itoa(n)+"W"); err != nil {
+				return err
+			}
+			return p.ofTheMonth()
+		}
+		return p.set(natDayOfWeek, "1-5")
+	case "weekend", "weekends":
+		p.pos++
+		p.accept("day", "days")
+		return p.set(natDayOfWeek, "0,6")
+	case "days":
+		// Short form of a day-of-month range: "days 1–15th".
+		p.pos++
+		return p.monthDays()
+	}
+	if cron, ok := natShorthands[tok]; ok {
+		if p.shorthand != "" && p.shorthand != cron {
+			return fmt.Errorf("both %q and another repetition", tok)
+		}
+		p.pos++
+		if tok == "twice" && !p.accept("daily") && !(p.accept("a") && p.accept("day")) {
+			return fmt.Errorf("expected %q after %q", "daily", tok)
+		}
+		p.shorthand, p.any = cron, true
+		return nil
+	}
+	if _, _, _, ok := p.peekTime(); ok {
+		return p.times()
+	}
+	if n, ok := natOrdinal(tok); ok {
+		if d, ok := natDayName(p.peek(1)); ok && n <= 5 {
+			p.pos += 2
+			if err := p.set(natDayOfWeek, fmt.Sprintf("%d#%d", d, n)); err != nil {
+				return err
+			}
+			return p.ofTheMonth()
+		}
+		business := p.peek(1) == "business" && p.peek(2) == "day"
+		if n == 1 && (business || p.peek(1) == "weekday" && p.peek(2) != "nearest") {
+			p.pos += 2 + btoi(business)
+			if err := p.set(natDayOfMonth, "1W"); err != nil {
+				return err
+			}
+			return p.ofTheMonth()
+		}
+		return p.monthDays()
+	}
+	if _, ok := natDayName(tok); ok {
+		return p.weekdays()
+	}
+	if _, ok := natMonthName(tok); ok {
+		return p.months()
+	}
+	return fmt.Errorf("unexpected %q", tok)
+}
+
+// every consumes a phrase starting with "every".
+func (p *naturalParser) every() error {
+	p.pos++
+	n := 1
+	if v, err := strconv.Atoi(p.peek(0)); err == nil && v > 0 {
+		n = v
+		p.pos++
+	} else if p.accept("other") {
+		n = 2
+	}
+	step := "*"
+	if n > 1 {
+		step = "*/" + strconv.Itoa(n)
+	}
+	switch tok := p.peek(0); tok {
+	case "second", "seconds", "sec", "secs":
+		p.pos++
+		if n > 1 && p.peek(0) == "from" && (p.peek(1) == "second" || p.peek(1) == "sec") {
+			p.pos++
+			return p.stepRange(natSecond, n)
+		}
+		return p.set(natSecond, step)
+	case "minute", "minutes", "min", "mins":
+		p.pos++
+		// "from minute 5", unlike "from 8am", bounds the minutes.
+		if n > 1 && p.peek(0) == "from" && (p.peek(1) == "minute" || p.peek(1) == "min") {
+			p.pos++
+			return p.stepRange(natMinute, n)
+		}
+		return p.set(natMinute, step)
+	case "hour", "hours":
+		p.pos++
+		if n > 1 && p.accept("from") {
+			return p.stepRange(natHour, n)
+		}
+		return p.set(natHour, step)
+	case "day", "days":
+		p.pos++
+		p.any = true
+		if n > 1 && p.accept("from") {
+			return p.stepRange(natDayOfMonth, n)
+		}
+		if n > 1 {
+			if err := p.set(natDayOfMonth, step); err != nil {
+				return err
+			}
+			return p.ofTheMonth()
+		}
+		return nil
+	case "month", "months":
+		p.pos++
+		p.any = true
+		if n > 1 {
+			return p.set(natMonth, step)
+		}
+		return nil
+	case "year", "years":
+		p.pos++
+		p.any = true
+		if !p.accept("from", "starting") {
+			if n > 1 {
+				return fmt.Errorf("every %d years needs a first year, as in \"from 2026\"", n)
+			}
+			return nil
+		}
+		y, err := p.year()
+		if err != nil {
+			return err
+		}
+		return p.set(natYear, fmt.Sprintf("%d/%d", y, n))
+	case "week":
+		if n > 1 {
+			return fmt.Errorf("every %d %ss", n, tok)
+		}
+		p.pos++
+		p.any = true
+		return nil
+	}
+	if n > 1 {
+		return fmt.Errorf("expected a unit after \"every %d\"", n)
+	}
+	// "every Monday", "every weekday", "every January", "every last Friday".
+	return p.clause()
+}
+
+// stepRange consumes the bounds of "every 3 minutes from minute 5 through
+// 20", "every 2 hours from 9am through 5pm" or "every 5 days from the 1st
+// through the 16th", setting field to every n-th value between them.
+func (p *naturalParser) stepRange(field, n int) error {
+	bound := func() (int, error) {
+		switch field {
+		case natHour:
+			h, m, s, ok := p.readTime()
+			if !ok {
+				return 0, fmt.Errorf("expected an hour, got %q", p.peek(0))
+			}
+			if m != 0 || s != 0 {
+				return 0, fmt.Errorf("%d:%02d is not a whole hour", h, m)
+			}
+			return h, nil
+		case natDayOfMonth:
+			p.accept("the")
+			d, ok := natOrdinal(p.peek(0))
+			if !ok || d < 1 || d > 31 {
+				return 0, fmt.Errorf("expected a day of the month, got %q", p.peek(0))
+			}
+			p.pos++
+			return d, nil
+		}
+		p.accept("minute", "min", "second", "sec")
+		v, err := strconv.Atoi(p.peek(0))
+		if err != nil || v < 0 || v > 59 {
+			return 0, fmt.Errorf("expected a %s, got %q", natFieldNames[field], p.peek(0))
+		}
+		p.pos++
+		return v, nil
+	}
+	first, err := bound()
+	if err != nil {
+		return err
+	}
+	if !p.accept("through", "to", "-") {
+		return fmt.Errorf("expected \"through\" after %d", first)
+	}
+	last, err := bound()
+	if err != nil {
+		return err
+	}
+	if err := p.set(field, fmt.Sprintf("%d-%d/%d", first, last, n)); err != nil {
+		return err
+	}
+	if field == natDayOfMonth {
+		return p.ofTheMonth()
+	}
+	p.accept("of")
+	return nil
+}
+
+// last consumes "last day", "last weekday", "last business day" and "last
+// Friday", each optionally followed by "of the month".
+func (p *naturalParser) last() error {
+	p.pos++
+	var err error
+	switch tok := p.peek(0); tok {
+	case "day":
+		p.pos++
+		err = p.set(natDayOfMonth, "L")
+	case "weekday", "business":
+		p.pos++
+		if tok == "business" && !p.accept("day") {
+			return fmt.Errorf("expected %q after %q", "day", tok)
+		}
+		err = p.set(natDayOfMonth, "LW")
+	default:
+		d, ok := natDayName(tok)
+		if !ok {
+			return fmt.Errorf("expected a day after %q", "last")
+		}
+		p.pos++
+		err = p.set(natDayOfWeek, strconv.Itoa(d)+"L")
+	}
+	if err != nil {
+		return err
+	}
+	return p.ofTheMonth()
+}
+
+// monthDays consumes days of the month: "1st and 15th", "1st–15th", "5".
+func (p *naturalParser) monthDays() error {
+	var items []string
+	for {
+		a, ok := natOrdinal(p.peek(0))
+		if !ok {
+			break
+		}
+		p.pos++
+		item := strconv.Itoa(a)
+		if p.peek(0) == "-" || p.peek(0) == "through" || p.peek(0) == "to" {
+			b, ok := natOrdinal(p.peek(1))
+			if !ok {
+				return fmt.Errorf("expected a day after %q", p.peek(0))
+			}
+			p.pos += 2
+			item += "-" + strconv.Itoa(b)
+		}
+		items = append(items, item)
+		if !p.accept("and") {
+			if _, ok := natOrdinal(p.peek(0)); !ok {
+				break
+			}
+		}
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("expected a day of the month, got %q", p.peek(0))
+	}
+	for _, item := range items {
+		for part := range strings.SplitSeq(item, "-") {
+			if n, _ := strconv.Atoi(part); n < 1 || n > 31 {
+				return fmt.Errorf("day %d of the month", n)
+			}
+		}
+	}
+	if err := p.set(natDayOfMonth, strings.Join(items, ",")); err != nil {
+		return err
+	}
+	return p.ofTheMonth()
+}
+
+// weekdays consumes a list or range of day names.
+func (p *naturalParser) weekdays() error {
+	items, err := p.names(natDayName)
+	if err != nil {
+		return err
+	}
+	return p.set(natDayOfWeek, items)
+}
+
+// months consumes a list or range of month names.
+func (p *naturalParser) months() error {
+	items, err := p.names(natMonthName)
+	if err != nil {
+		return err
+	}
+	return p.set(natMonth, items)
+}
+
+// names consumes names joined by "and" or commas, or two joined by a dash,
+// "to" or "through", returning them as a cron field.
+func (p *naturalParser) names(lookup func(string) (int, bool)) (string, error) {
+	var items []string
+	for {
+		a, ok := lookup(p.peek(0))
+		if !ok {
+			break
+		}
+		p.pos++
+		item := strconv.Itoa(a)
+		if sep := p.peek(0); sep == "-" || sep == "to" || sep == "through" || sep == "until" {
+			b, ok := lookup(p.peek(1))
+			if !ok {
+				return "", fmt.Errorf("expected a name after %q", sep)
+			}
+			p.pos += 2
+			item += "-" + strconv.Itoa(b)
+		}
+		items = append(items, item)
+		if p.peek(0) == "and" {
+			if _, ok := lookup(p.peek(1)); ok {
+				p.pos++
+			}
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// numberList consumes the minutes after "at minute", or the seconds after
+// "at second", singly or as ranges: "0–5 and 30".
+func (p *naturalParser) numberList(field int) error {
+	name := natFieldNames[field]
+	var items []string
+	for {
+		n, err := strconv.Atoi(p.peek(0))
+		if err != nil {
+			break
+		}
+		if n < 0 || n > 59 {
+			return fmt.Errorf("%s %d", name, n)
+		}
+		p.pos++
+		item := strconv.Itoa(n)
+		if sep := p.peek(0); sep == "-" || sep == "through" || sep == "to" {
+			n2, err := strconv.Atoi(p.peek(1))
+			if err != nil || n2 < 0 || n2 > 59 {
+				return fmt.Errorf("expected a %s after %q", name, sep)
+			}
+			p.pos += 2
+			item += "-" + strconv.Itoa(n2)
+		}
+		items = append(items, item)
+		p.accept("and")
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("expected a %s, got %q", name, p.peek(0))
+	}
+	return p.set(field, strings.Join(items, ","))
+}
+
+// years consumes a list or range of years: "2026", "2025 and 2027",
+// "2024, 2025, and 2027", "2025–2027".
+func (p *naturalParser) years() error {
+	var items []string
+	for {
+		y, err := p.year()
+		if err != nil {
+			return err
+		}
+		item := strconv.Itoa(y)
+		if p.accept("-", "to", "through") {
+			y2, err := p.year()
+			if err != nil {
+				return err
+			}
+			item += "-" + strconv.Itoa(y2)
+		}
+		items = append(items, item)
+		next := 0
+		if p.peek(0) == "and" {
+			next = 1
+		}
+		if _, err := strconv.Atoi(p.peek(next)); err != nil {
+			break
+		}
+		p.pos += next
+	}
+	return p.set(natYear, strings.Join(items, ","))
+}
+
+// year consumes a year Expression supports.
+func (p *naturalParser) year() (int, error) {
+	y, err := strconv.Atoi(p.peek(0))
+	if err != nil || y < minYear || y > maxYear {
+		return 0, fmt.Errorf("expected a year from %d to %d, got %q", minYear, maxYear, p.peek(0))
+	}
+	p.pos++
+	return y, nil
+}
+
+// hours consumes the whole hours of "during the 9:00 AM and 5:00 PM hours"
+// or "during the 9 AM–11 AM hours", leaving the minutes to another clause.
+func (p *naturalParser) hours() error {
+	var items []string
+	for {
+		h, m, s, ok := p.readTime()
+		if !ok {
+			return fmt.Errorf("expected an hour, got %q", p.peek(0))
+		}
+		if m != 0 || s != 0 {
+			return fmt.Errorf("%d:%02d is not a whole hour", h, m)
+		}
+		item := strconv.Itoa(h)
+		if p.accept("-", "to", "through") {
+			h2, m2, s2, ok := p.readTime()
+			if !ok || m2 != 0 || s2 != 0 {
+				return fmt.Errorf("expected a whole hour, got %q", p.peek(0))
+			}
+			item += "-" + strconv.Itoa(h2)
+		}
+		items = append(items, item)
+		if p.accept("hour", "hours") {
+			return p.set(natHour, strings.Join(items, ","))
+		}
+		p.accept("and")
+	}
+}
+
+// times consumes a list of clock times joined by "and" or commas, or a range
+// of hours joined by a dash, "to", "through", "until" or "and" after
+// "between".
+func (p *naturalParser) times() error {
+	h, m, s, ok := p.readTime()
+	if !ok {
+		return fmt.Errorf("expected a time, got %q", p.peek(0))
+	}
+	if sep := p.peek(0); sep == "-" || sep == "to" || sep == "through" || sep == "until" || sep == "and" && p.between {
+		save := p.pos
+		p.pos++
+		h2, m2, s2, ok := p.readTime()
+		if ok {
+			if m != 0 || m2 != 0 || s != 0 || s2 != 0 {
+				return fmt.Errorf("a range of times must be whole hours")
+			}
+			return p.set(natHour, fmt.Sprintf("%d-%d", h, h2))
+		}
+		p.pos = save
+	}
+	hours := []string{strconv.Itoa(h)}
+	for {
+		save := p.pos
+		p.accept("and")
+		h2, m2, s2, ok := p.readTime()
+		if !ok {
+			p.pos = save
+			break
+		}
+		if m2 != m || s2 != s {
+			return fmt.Errorf("times with different minutes or seconds need separate expressions")
+		}
+		hours = append(hours, strconv.Itoa(h2))
+	}
+	if err := p.set(natHour, strings.Join(hours, ",")); err != nil {
+		return err
+	}
+	if err := p.set(natMinute, strconv.Itoa(m)); err != nil {
+		return err
+	}
+	if s == 0 {
+		// Seconds default to zero, and "at seconds 15 and 45" may give them.
+		return nil
+	}
+	return p.set(natSecond, strconv.Itoa(s))
+}
+
+// peekTime reports whether a clock time starts at the current token.
+func (p *naturalParser) peekTime() (h, m, s int, ok bool) {
+	save := p.pos
+	defer func() { p.pos = save }()
+	return p.readTime()
+}
+
+// readTime consumes a clock time: "noon", "midnight", "9", "9am", "9 pm",
+// "9:30", "9:30:15 AM" or "21:00". A bare number is a time only when an am
+// or pm marker follows, or when it comes after "at".
+func (p *naturalParser) readTime() (h, m, s int, ok bool) {
+	tok := p.peek(0)
+	switch tok {
+	case "noon", "midday":
+		p.pos++
+		return 12, 0, 0, true
+	case "midnight":
+		p.pos++
+		return 0, 0, 0, true
+	}
+	suffix := ""
+	for _, sfx := range []string{"am", "pm"} {
+		if strings.HasSuffix(tok, sfx) && len(tok) > len(sfx) {
+			tok, suffix = strings.TrimSuffix(tok, sfx), sfx
+		}
+	}
+	parts := strings.Split(tok, ":")
+	if len(parts) > 3 {
+		return 0, 0, 0, false
+	}
+	nums := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || (i == 0 && len(part) > 2) || (i > 0 && (len(part) != 2 || n > 59)) {
+			return 0, 0, 0, false
+		}
+		nums[i] = n
+	}
+	next := p.peek(1)
+	if suffix == "" && (next == "am" || next == "pm") {
+		suffix = next
+	}
+	afterAt := p.pos > 0 && (p.toks[p.pos-1] == "at" || p.toks[p.pos-1] == "@")
+	if len(parts) == 1 && suffix == "" && !afterAt {
+		return 0, 0, 0, false
+	}
+	h = nums[0]
+	switch suffix {
+	case "am", "pm":
+		if h < 1 || h > 12 {
+			return 0, 0, 0, false
+		}
+		h %= 12
+		if suffix == "pm" {
+			h += 12
+		}
+	default:
+		if h > 23 {
+			return 0, 0, 0, false
+		}
+	}
+	p.pos++
+	if suffix != "" && p.peek(0) == suffix {
+		p.pos++
+	}
+	p.accept("o'clock", "oclock")
+	return h, nums[1], nums[2], true
+}
+
+// natDayName looks up a day name, abbreviated or plural.
+func natDayName(s string) (int, bool) {
+	s = strings.TrimSuffix(s, "s")
+	if d, ok := dowTokens[s]; ok && s != "7" && !isDigits(s) {
+		return d, true
+	}
+	return 0, false
+}
+
+// natMonthName looks up a month name, abbreviated or not.
+func natMonthName(s string) (int, bool) {
+	if m, ok := monthTokens[s]; ok && !isDigits(s) {
+		return m, true
+	}
+	return 0, false
+}
+
+// natOrdinal parses a day ordinal such as "1st" or "first", or a bare day
+// number.
+func natOrdinal(s string) (int, bool) {
+	if n, ok := natOrdinalWords[s]; ok {
+		return n, true
+	}
+	for _, sfx := range []string{"st", "nd", "rd", "th"} {
+		if strings.HasSuffix(s, sfx) {
+			s = strings.TrimSuffix(s, sfx)
+			break
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 31 {
+		return 0, false
+	}
+	return n, true
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,174 @@
+package cronexpr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sameSchedule reports whether two expressions hold the same parsed fields,
+// ignoring the source text and whether times were written as lists or steps.
+func sameSchedule(a, b *Expression) bool {
+	x, y := *a, *b
+	x.normalized, y.normalized = "", ""
+	x.byClock, y.byClock = false, false
+	return reflect.DeepEqual(x, y)
+}
+
+func TestParseNatural(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"every weekday at 9:30am", "30 9 * * 1-5"},
+		{"every 15 minutes", "*/15 * * * *"},
+		{"every minute", "* * * * *"},
+		{"every hour", "0 * * * *"},
+		{"every 2 hours", "0 */2 * * *"},
+		{"every 10 seconds", "*/10 * * * * * *"},
+		{"on the last Friday of the month at 6pm", "0 18 * * 5L"},
+		{"at noon on the 1st and 15th", "0 12 1,15 * *"},
+		{"every Monday in January", "0 0 * 1 1"},
+		{"every Monday and Thursday at 8 a.m.", "0 8 * * 1,4"},
+		{"Mon-Fri at 17:45", "45 17 * * 1-5"},
+		{"at 9 and 5pm on weekends", "0 9,17 * * 0,6"},
+		{"at midnight on the last day of the month", "0 0 L * *"},
+		{"on the last weekday of the month", "0 0 LW * *"},
+		{"on the weekday nearest the 15th at 9am", "0 9 15W * *"},
+		{"on the second Tuesday of every month at 7:15 pm", "15 19 * * 2#2"},
+		{"every 15 minutes between 9am and 5pm on weekdays", "*/15 9-17 * * 1-5"},
+		{"every 30 minutes from 8am to 6pm", "*/30 8-18 * * *"},
+		{"daily at 6:30", "30 6 * * *"},
+		{"weekly", "0 0 * * 0"},
+		{"monthly on the 15th", "0 0 15 * *"},
+		{"hourly", "0 * * * *"},
+		{"every other day at 3am", "0 3 */2 * *"},
+		{"every other day of the month at 3am", "0 3 */2 * *"},
+		{"on January 1st at 00:00", "0 0 1 1 *"},
+		{"at 9:00:30 on the 5th of June in 2027", "30 0 9 5 6 * 2027"},
+		{"every Sunday at 2:00 AM", "0 2 * * 0"},
+		{"every 2 hours from 9am to 5pm", "0 9-17/2 * * *"},
+		{"every 3 minutes from minute 5 through 20", "5-20/3 * * * *"},
+		{"every 15 minutes during business hours", "*/15 9-17 * * 1-5"},
+		{"twice daily", "0 0,12 * * *"},
+		{"twice a day at 6am and 6pm", "0 6,18 * * *"},
+		{"quarterly on the 15th", "0 0 15 1,4,7,10 *"},
+		{"semiannually", "0 0 1 1,7 *"},
+		{"on the first business day of the month at 9am", "0 9 1W * *"},
+		{"on the last business day of the month", "0 0 LW * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseNatural(tt.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := MustParse(tt.want); !sameSchedule(got, want) {
+				t.Errorf("ParseNatural = %q, want %q", got.normalized, tt.want)
+			}
+		})
+	}
+
+	for _, text := range []string{
+		"",
+		"whenever",
+		"at 9:00 and 17:30",
+		"every 2 weeks",
+		"at 9am at 10am",
+		"on the 32nd",
+		"at 25:00",
+		"every 3",
+		"last",
+		"every 61 minutes",
+	} {
+		got, err := ParseNatural(text)
+		if err == nil {
+			t.Errorf("ParseNatural(%q) = %q, want an error", text, got.normalized)
+			continue
+		}
+		if prefix := fmt.Sprintf("natural schedule %q: ", text); !strings.HasPrefix(err.Error(), prefix) {
+			t.Errorf("ParseNatural(%q) error = %q, want prefix %q", text, err, prefix)
+		}
+	}
+}
+
+func TestParseNaturalRoundTrip(t *testing.T) {
+	for _, src := range []string{
+		"* * * * *",
+		"*/15 * * * *",
+		"5 * * * *",
+		"0 */2 * * *",
+		"*/10 */3 * * *",
+		"0 9 * * *",
+		"30 9 * * 1-5",
+		"0 9,17 * * *",
+		"0 9-17 * * *",
+		"*/15 9-17 * * 1-5",
+		"30 9 * * 1,3,5",
+		"0 8 * * 6",
+		"0 0 1 * *",
+		"0 0 1,15 * *",
+		"0 0 1-15 * *",
+		"0 0 */2 * *",
+		"0 0 L * *",
+		"0 9 15W * *",
+		"0 18 * * 5L",
+		"0 12 * * 1#2",
+		"0 0 13 * 5",
+		"0 0 * 1 1",
+		"0 0 1 1,7 *",
+		"0 0 * 1-3 *",
+		"0 12 * 6 *",
+		"0,30 9 * * *",
+		"*/15 9,17 * * *",
+		"* 9 * * *",
+		"0 0 LW * *",
+		"0 0 1,15,L * *",
+		"0 9 * * 2#3,4#3",
+		"0 9 * * 1-5,5L",
+		"0 0 * 1,2 *",
+		"0 9 * * 1 2025",
+		"*/10 * * * * * *",
+		"30 0 9 * * * *",
+		"30 * * * * * *",
+		"*/10 * 9-17 * * * *",
+		"0 0 12 * * * 2025-2027",
+		"0 0 0 1 1 * 2024/2",
+		"0 0 9 * * 1 2025,2027",
+		"5-20/3 * * * *",
+		"10/15 * * * *",
+		"0-5,30 * * * *",
+		"0 22-3 * * *",
+		"*/15 9-17/2 * * *",
+		"*/5 9-11,14-16 * * *",
+		"0 0 1,15-20,L * *",
+		"0 0 25-5 * *",
+		"0 0 1-20/5 * *",
+		"0 9 * * MON-WED,FRI",
+		"0 9 * * FRI-MON",
+		"0 0 1 NOV-FEB *",
+		"0 0 * JAN-MAR,OCT *",
+		"5-55/10 * * * * * *",
+		"0 0 0 1 1 * 2030-2020",
+		"0 9-17 * * 1-5",
+		"0 */12 * * *",
+		"0 0 1 1,4,7,10 *",
+		"0 0 L 1,7 *",
+		"0 9 1W * *",
+		"0 9 * * 0,6",
+	} {
+		expr := MustParse(src)
+		for _, opts := range []*DescribeOptions{nil, {Short: true}} {
+			desc := expr.Describe(opts)
+			got, err := ParseNatural(desc)
+			if err != nil {
+				t.Errorf("%q: ParseNatural(%q): %v", src, desc, err)
+				continue
+			}
+			if !sameSchedule(got, expr) {
+				t.Errorf("%q: ParseNatural(%q) = %q", src, desc, got.normalized)
+			}
+		}
+	}
+}
@@ -0,0 +1,412 @@
+package cronexpr
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoOnCalendar is returned by ToOnCalendar for expressions that no set of
+// systemd calendar events reproduces exactly.
+var ErrNoOnCalendar = errors.New("no equivalent OnCalendar value")
+
+// onCalendarShorthands are the named systemd calendar events.
+var onCalendarShorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+}
+
+// onCalendarDayNames are the systemd weekday names, Monday first as systemd
+// counts them.
+var onCalendarDayNames = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// FromOnCalendar converts a systemd calendar event, the value of a timer's
+// OnCalendar= setting, to a cron expression. It accepts the normalized form
+// "[weekdays] [year-]month-day [hour:minute[:second]]" with lists, ranges
+// (a..b) and repetitions (a/n) in each component, ~ for days counted from the
+// end of the month, and the shorthands such as daily and weekly.
+//
+// systemd runs on days matching both the weekday and the date, where cron
+// runs on days matching either. Weekdays are therefore only combined with
+// the days cron can intersect: a week of the month such as Sat *-*-1..7
+// becomes 6#1, and the last week (~07/1) becomes 6L. Other combinations, time
+// zones and fractional seconds return an error wrapping ErrNoCron.
+func FromOnCalendar(spec string) (*Expression, error) {
+	fail := func(format string, args ...any) (*Expression, error) {
+		return nil, fmt.Errorf("OnCalendar %q: "+format, append([]any{spec}, args...)...)
+	}
+	tokens := strings.Fields(spec)
+	if len(tokens) == 1 {
+		if expanded, ok := onCalendarShorthands[strings.ToLower(tokens[0])]; ok {
+			tokens = strings.Fields(expanded)
+		}
+	}
+	if len(tokens) == 0 {
+		return fail("empty calendar event")
+	}
+
+	var weekdays map[int]bool
+	if c := tokens[0][0]; c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' {
+		days, err := parseOnCalendarWeekdays(tokens[0])
+		if err != nil {
+			return fail("%w", err)
+		}
+		weekdays, tokens = days, tokens[1:]
+	}
+	date, clock := "*-*-*", "00:00:00"
+	if len(tokens) > 0 && !strings.Contains(tokens[0], ":") {
+		date, tokens = tokens[0], tokens[1:]
+	}
+	if len(tokens) > 0 {
+		clock, tokens = tokens[0], tokens[1:]
+	}
+	if len(tokens) > 0 {
+		return fail("%w: time zone %q; convert the schedule to local time", ErrNoCron, tokens[0])
+	}
+
+	// Date: [year-]month-day or [year-]month~day.
+	var year, month, day string
+	sep := strings.IndexByte(date, '~')
+	head, tail := date, ""
+	if sep >= 0 {
+		head, tail = date[:sep], date[sep:]
+	}
+	parts := strings.Split(head, "-")
+	if sep < 0 {
+		if len(parts) < 2 {
+			return fail("date %q is not [year-]month-day", date)
+		}
+		parts, tail = parts[:len(parts)-1], parts[len(parts)-1]
+	}
+	switch len(parts) {
+	case 1:
+		year, month = "*", parts[0]
+	case 2:
+		year, month = parts[0], parts[1]
+	default:
+		return fail("date %q is not [year-]month-day", date)
+	}
+	day = tail
+
+	times := strings.Split(clock, ":")
+	if len(times) == 2 {
+		times = append(times, "00")
+	}
+	if len(times) != 3 {
+		return fail("time %q is not hour:minute[:second]", clock)
+	}
+	if strings.Contains(times[2], ".") {
+		return fail("%w: fractional seconds in %q", ErrNoCron, clock)
+	}
+
+	fields := make([]string, 7)
+	for i, c := range []struct {
+		text, name string
+		lo, hi     int
+	}{
+		{times[2], "second", 0, 59},
+		{times[1], "minute", 0, 59},
+		{times[0], "hour", 0, 23},
+		{"", "", 0, 0},
+		{month, "month", 1, 12},
+		{"", "", 0, 0},
+		{year, "year", 1970, 2099},
+	} {
+		if c.name == "" {
+			continue
+		}
+		f, err := onCalendarField(c.text, c.name, c.lo, c.hi)
+		if err != nil {
+			return fail("%w", err)
+		}
+		fields[i] = f
+	}
+
+	dom, dow, err := onCalendarDayFields(day, weekdays)
+	if err != nil {
+		return fail("%w", err)
+	}
+	fields[3], fields[5] = dom, dow
+	return Parse(strings.Join(fields, " "))
+}
+
+// parseOnCalendarWeekdays parses a weekday list such as Mon..Fri,Sun into
+// cron day numbers.
+func parseOnCalendarWeekdays(s string) (map[int]bool, error) {
+	index := func(name string) (int, error) {
+		for i, d := range onCalendarDayNames {
+			full := time.Weekday((i + 1) % daysPerWeek).String()
+			if strings.EqualFold(name, d) || strings.EqualFold(name, full) {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown weekday %q", name)
+	}
+	days := map[int]bool{}
+	for item := range strings.SplitSeq(s, ",") {
+		lo, hi, isRange := strings.Cut(item, "..")
+		first, err := index(lo)
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if isRange {
+			if last, err = index(hi); err != nil {
+				return nil, err
+			}
+			if last < first {
+				return nil, fmt.Errorf("weekday range %q runs backwards", item)
+			}
+		}
+		for i := first; i <= last; i++ {
+			days[(i+1)%daysPerWeek] = true
+		}
+	}
+	return days, nil
+}
+
+// onCalendarField converts one numeric component of a calendar event to the
+// matching cron field, checking its values against [lo, hi].
+func onCalendarField(s, name string, lo, hi int) (string, error) {
+	if s == "*" {
+		return "*", nil
+	}
+	number := func(v string) (int, error) {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < lo || n > hi {
+			return 0, fmt.Errorf("%s %q is not a number in %d..%d", name, v, lo, hi)
+		}
+		return n, nil
+	}
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		var out string
+		if base == "*" {
+			out = "*"
+		} else {
+			first, last, isRange := strings.Cut(base, "..")
+			a, err := number(first)
+			if err != nil {
+				return "", err
+			}
+			out = strconv.Itoa(a)
+			if isRange {
+				b, err := number(last)
+				if err != nil {
+					return "", err
+				}
+				if b < a {
+					return "", fmt.Errorf("%s range %q runs backwards", name, base)
+				}
+				out += "-" + strconv.Itoa(b)
+			}
+		}
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return "", fmt.Errorf("%s repetition %q is not a positive number", name, step)
+			}
+			out += "/" + strconv.Itoa(n)
+		}
+		items = append(items, out)
+	}
+	return strings.Join(items, ","), nil
+}
+
+// onCalendarDayFields converts the day component and weekdays of a calendar event
+// to the cron day-of-month and day-of-week fields.
+func onCalendarDayFields(day string, weekdays map[int]bool) (dom, dow string, err error) {
+	dow = "*"
+	if weekdays != nil {
+		dow = joinInts(toList(weekdays))
+	}
+	if strings.HasPrefix(day, "~") {
+		switch day {
+		case "~1", "~01":
+			dom = "L"
+		case "~7/1", "~07/1":
+			if weekdays == nil {
+				return "", "", fmt.Errorf("%w: the last seven days of the month", ErrNoCron)
+			}
+			var last []string
+			for _, d := range toList(weekdays) {
+				last = append(last, strconv.Itoa(d)+"L")
+			}
+			return "*", strings.Join(last, ","), nil
+		default:
+			return "", "", fmt.Errorf("%w: day %q counted from the end of the month", ErrNoCron, day)
+		}
+	} else if dom, err = onCalendarField(day, "day", 1, 31); err != nil {
+		return "", "", err
+	}
+	if weekdays == nil || dom == "*" {
+		return dom, dow, nil
+	}
+
+	// A weekday on a week of the month is the n-th such weekday.
+	first, last, _ := strings.Cut(dom, "-")
+	a, _ := strconv.Atoi(first)
+	b, _ := strconv.Atoi(last)
+	if !strings.ContainsAny(dom, ",/L") && (a-1)%daysPerWeek == 0 && (b == a+daysPerWeek-1 || a == 29 && b == 31) {
+		var nth []string
+		for _, d := range toList(weekdays) {
+			nth = append(nth, fmt.Sprintf("%d#%d", d, a/daysPerWeek+1))
+		}
+		return "*", strings.Join(nth, ","), nil
+	}
+	return "", "", fmt.Errorf("%w: weekdays with day %q run on days matching both, cron on days matching either", ErrNoCron, day)
+}
+
+// ToOnCalendar converts the cron expression to systemd calendar events, one
+// per OnCalendar= line. Most expressions need one; restricting both day of
+// month and day of week gives one for each, since a timer with several
+// OnCalendar= lines runs on their union as cron does. 1#2 becomes
+// Mon *-*-08..14 and 5L becomes Fri *-*~07/1.
+//
+// Expressions using W or LW, and rate() schedules, have no equivalent and
+// return an error wrapping ErrNoOnCalendar.
+func (expr *Expression) ToOnCalendar() ([]string, error) {
+	if expr.interval > 0 {
+		return nil, fmt.Errorf("%w: rate() interval", ErrNoOnCalendar)
+	}
+	if len(expr.workdaysOfMonth) > 0 {
+		return nil, fmt.Errorf("%w: nearest weekday (W) in day-of-month field", ErrNoOnCalendar)
+	}
+	if expr.lastWorkdayOfMonth {
+		return nil, fmt.Errorf("%w: last weekday (LW) in day-of-month field", ErrNoOnCalendar)
+	}
+	year := "*"
+	if !slices.Equal(expr.yearList, yearDefaultList) {
+		year = onCalendarList(expr.yearList, 1970, 2099, 4)
+	}
+	month := onCalendarList(expr.monthList, 1, 12, 2)
+	clock := onCalendarList(expr.hourList, 0, 23, 2) + ":" +
+		onCalendarList(expr.minuteList, 0, 59, 2) + ":" +
+		onCalendarList(expr.secondList, 0, 59, 2)
+	event := func(weekdays []int, sep, day string) string {
+		s := year + "-" + month + sep + day + " " + clock
+		if len(weekdays) > 0 {
+			s = onCalendarWeekdays(weekdays) + " " + s
+		}
+		return s
+	}
+
+	var events []string
+	if expr.daysOfMonthRestricted {
+		if days := toList(expr.daysOfMonth); len(days) > 0 {
+			events = append(events, event(nil, "-", onCalendarList(days, 1, 31, 2)))
+		}
+		if expr.lastDayOfMonth {
+			events = append(events, event(nil, "~", "01"))
+		}
+	}
+	if expr.daysOfWeekRestricted {
+		if days := toList(expr.daysOfWeek); len(days) > 0 {
+			events = append(events, event(days, "-", "*"))
+		}
+		// Group n-th weekdays by week so each week is one event.
+		weeks := map[int][]int{}
+		for _, k := range toList(expr.specificWeekDaysOfWeek) {
+			weeks[k/daysPerWeek+1] = append(weeks[k/daysPerWeek+1], k%daysPerWeek)
+		}
+		for _, n := range slices.Sorted(maps.Keys(weeks)) {
+			first := (n-1)*daysPerWeek + 1
+			day := fmt.Sprintf("%02d..%02d", first, min(first+daysPerWeek-1, 31))
+			events = append(events, event(weeks[n], "-", day))
+		}
+		if last := toList(expr.lastWeekDaysOfWeek); len(last) > 0 {
+			events = append(events, event(last, "~", "07/1"))
+		}
+	}
+	if len(events) == 0 {
+		events = append(events, event(nil, "-", "*"))
+	}
+	return events, nil
+}
+
+// TimerUnit returns a systemd .timer unit running the expression, with
+// description as its Description= and one OnCalendar= line per event from
+// ToOnCalendar. Expressions with seconds get AccuracySec=1s, since systemd
+// otherwise coalesces runs within a minute.
+func (expr *Expression) TimerUnit(description string) (string, error) {
+	events, err := expr.ToOnCalendar()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("[Unit]\n")
+	fmt.Fprintf(&b, "Description=%s\n\n", description)
+	b.WriteString("[Timer]\n")
+	for _, e := range events {
+		fmt.Fprintf(&b, "OnCalendar=%s\n", e)
+	}
+	if !slices.Equal(expr.secondList, []int{0}) {
+		b.WriteString("AccuracySec=1s\n")
+	}
+	b.WriteString("\n[Install]\nWantedBy=timers.target\n")
+	return b.String(), nil
+}
+
+// onCalendarList formats sorted values as a calendar event component: * for
+// the full range [lo, hi], start/step for a repetition running to hi, and
+// otherwise a list with runs of three or more written as ranges.
+func onCalendarList(values []int, lo, hi, width int) string {
+	if len(values) == hi-lo+1 {
+		return "*"
+	}
+	pad := func(v int) string { return fmt.Sprintf("%0*d", width, v) }
+	if len(values) > 2 {
+		step := values[1] - values[0]
+		progression := step > 1 && values[len(values)-1]+step > hi
+		for i := 2; progression && i < len(values); i++ {
+			progression = values[i]-values[i-1] == step
+		}
+		if progression {
+			return pad(values[0]) + "/" + strconv.Itoa(step)
+		}
+	}
+	return joinRuns(values, pad, "..")
+}
+
+// onCalendarWeekdays formats cron day numbers as systemd weekday names,
+// Monday first, with runs of three or more written as ranges.
+func onCalendarWeekdays(days []int) string {
+	monday := make([]int, len(days))
+	for i, d := range days {
+		monday[i] = (d + daysPerWeek - 1) % daysPerWeek
+	}
+	slices.Sort(monday)
+	return joinRuns(monday, func(i int) string { return onCalendarDayNames[i] }, "..")
+}
+
+// joinRuns joins sorted values with commas, writing runs of three or more
+// consecutive values as first, sep, last.
+func joinRuns(values []int, format func(int) string, sep string) string {
+	var items []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			items = append(items, format(values[i])+sep+format(values[j]))
+			i = j + 1
+			continue
+		}
+		items = append(items, format(values[i]))
+		i++
+	}
+	return strings.Join(items, ",")
+}
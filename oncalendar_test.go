@@ -0,0 +1,131 @@
+package cronexpr_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+)
+
+func TestFromOnCalendar(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"Mon..Fri *-*-* 09:00:00", "0 9 * * 1-5"},
+		{"*-*-01 00:00:00", "0 0 1 * *"},
+		{"Sat *-*-1..7 18:00", "0 18 * * 6#1"},
+		{"Tue,Thu *-*-15..21 12:30", "30 12 * * 2#3,4#3"},
+		{"Fri *-*-29..31 17:00", "0 17 * * 5#5"},
+		{"Mon *-05~07/1 08:00", "0 8 * 5 1L"},
+		{"*-*~01 23:59", "59 23 L * *"},
+		{"daily", "0 0 * * *"},
+		{"weekly", "0 0 * * 1"},
+		{"Quarterly", "0 0 1 1,4,7,10 *"},
+		{"hourly", "0 * * * *"},
+		{"*:0/15", "*/15 * * * *"},
+		{"Sat,Sun 10:00", "0 10 * * 0,6"},
+		{"Monday..Wednesday,Sunday 07:15", "15 7 * * 0-3"},
+		{"*-*-* 08..18/2:00:30", "30 0 8-18/2 * * * *"},
+		{"2027-12-25 06:00", "0 0 6 25 12 * 2027"},
+		{"12-25", "0 0 25 12 *"},
+	}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			expr, err := cronexpr.FromOnCalendar(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, want := expr.NextN(from, 30), cronexpr.MustParse(tt.want).NextN(from, 30)
+			if !slices.EqualFunc(got, want, time.Time.Equal) {
+				t.Errorf("fires at %v, want %v", got, want)
+			}
+		})
+	}
+
+	for _, spec := range []string{
+		"Mon *-*-13 00:00",
+		"*-*~03 00:00",
+		"*-*~07/1 00:00",
+		"*-*-* 00:00:00 Europe/Berlin",
+		"*-*-* 00:00:00.5",
+	} {
+		if _, err := cronexpr.FromOnCalendar(spec); !errors.Is(err, cronexpr.ErrNoCron) {
+			t.Errorf("FromOnCalendar(%q) error = %v, want ErrNoCron", spec, err)
+		}
+	}
+	for _, spec := range []string{"", "Funday 00:00", "Fri..Mon 00:00", "*-*-32 00:00", "25:00", "*-13-01", "*-*-* 00:00:00:00", "*-*-*/0 00:00"} {
+		if _, err := cronexpr.FromOnCalendar(spec); err == nil || errors.Is(err, cronexpr.ErrNoCron) {
+			t.Errorf("FromOnCalendar(%q) error = %v, want a syntax error", spec, err)
+		}
+	}
+}
+
+func TestToOnCalendar(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"0 9 * * 1-5", []string{"Mon..Fri *-*-* 09:00:00"}},
+		{"*/15 * * * *", []string{"*-*-* *:00/15:00"}},
+		{"0 0 1,15 * *", []string{"*-*-01,15 00:00:00"}},
+		{"0 18 * * 6#1", []string{"Sat *-*-01..07 18:00:00"}},
+		{"0 17 * * 5L", []string{"Fri *-*~07/1 17:00:00"}},
+		{"0 0 L 2 *", []string{"*-02~01 00:00:00"}},
+		{"0 0 13 * 5", []string{"*-*-13 00:00:00", "Fri *-*-* 00:00:00"}},
+		{"0 12 * * 0,6", []string{"Sat,Sun *-*-* 12:00:00"}},
+		{"30 8 * 1-3,6 *", []string{"*-01..03,06-* 08:30:00"}},
+		{"15 0 0 1 1 * 2030", []string{"2030-01-01 00:00:15"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := cronexpr.MustParse(tt.expr).ToOnCalendar()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ToOnCalendar = %q, want %q", got, tt.want)
+			}
+			// Each single event converts back to the same schedule.
+			if len(got) == 1 {
+				back, err := cronexpr.FromOnCalendar(got[0])
+				if err != nil {
+					t.Fatal(err)
+				}
+				from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+				if !slices.EqualFunc(back.NextN(from, 30), cronexpr.MustParse(tt.expr).NextN(from, 30), time.Time.Equal) {
+					t.Errorf("%q does not round-trip", got[0])
+				}
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 9 15W * *", "0 9 LW * *"} {
+		if _, err := cronexpr.MustParse(expr).ToOnCalendar(); !errors.Is(err, cronexpr.ErrNoOnCalendar) {
+			t.Errorf("ToOnCalendar(%q) error = %v, want ErrNoOnCalendar", expr, err)
+		}
+	}
+}
+
+func TestTimerUnit(t *testing.T) {
+	got, err := cronexpr.MustParse("*/30 0 13 * 5").TimerUnit("Friday the 13th check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[Unit]
+Description=Friday the 13th check
+
+[Timer]
+OnCalendar=*-*-13 00:00,30:00
+OnCalendar=Fri *-*-* 00:00,30:00
+
+[Install]
+WantedBy=timers.target
+`
+	if got != want {
+		t.Errorf("TimerUnit =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package cronexpr
+
+// Option configures the timer-based facilities in this package. Options that
+// do not apply to a facility are ignored by it.
+type Option func(*config)
+
+// config holds the settings assembled from a list of Options.
+type config struct {
+	clock      Clock
+	tickPolicy TickPolicy
+	hooks      Hooks
+	store      Store
+}
+
+// newConfig applies opts over the defaults.
+func newConfig(opts []Option) config {
+	cfg := config{
+		clock:      SystemClock{},
+		tickPolicy: DropTicks,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithClock sets the Clock used to read the current time and wait for
+// scheduled instants. The default is SystemClock.
+func WithClock(c Clock) Option {
+	return func(cfg *config) {
+		cfg.clock = c
+	}
+}
+
+// WithTickPolicy sets how a Ticker handles ticks its reader is not ready to
+// receive. The default is DropTicks.
+func WithTickPolicy(p TickPolicy) Option {
+	return func(cfg *config) {
+		cfg.tickPolicy = p
+	}
+}
+
+// WithHooks sets the hooks a Scheduler calls as runs are skipped, replaced or
+// fail.
+func WithHooks(h Hooks) Option {
+	return func(cfg *config) {
+		cfg.hooks = h
+	}
+}
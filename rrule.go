@@ -0,0 +1,391 @@
+package cronexpr
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoRRULE is returned by ToRRULE for expressions that no set of RFC 5545
+// recurrence rules reproduces exactly.
+var ErrNoRRULE = errors.New("no equivalent RRULE")
+
+// rruleDays are the RFC 5545 weekday codes, indexed by time.Weekday.
+var rruleDays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// ToRRULE converts the cron expression to RFC 5545 RRULE values, without the
+// "RRULE:" prefix. Most expressions need a single rule; L and # days map to
+// BYMONTHDAY=-1, BYDAY=-1FR and BYDAY=2MO. Restricting both day of month and
+// day of week gives one rule for each, since cron runs on the union of the
+// two while an RRULE matches their intersection.
+//
+// The rules are meant to be used with a DTSTART that is itself an
+// occurrence, as produced by Recurrence: parts equal to the defaults taken
+// from DTSTART are omitted.
+//
+// RFC 5545 runs each wall-clock time once, even when the clocks fall back
+// and repeat it, so the rules leave out the second runs that expressions
+// with * or a step in the minute or hour field make during a repeated hour.
+//
+// Expressions using W, LW with several times a day, or an explicit year
+// field, and rate() schedules, have no equivalent and return an error wrapping
+// ErrNoRRULE.
+func (expr *Expression) ToRRULE() ([]string, error) {
+	if expr.interval > 0 {
+		return nil, fmt.Errorf("%w: rate() interval", ErrNoRRULE)
+	}
+	if !slices.Equal(expr.yearList, yearDefaultList) {
+		return nil, fmt.Errorf("%w: year field", ErrNoRRULE)
+	}
+	if len(expr.workdaysOfMonth) > 0 {
+		return nil, fmt.Errorf("%w: nearest weekday (W) in day-of-month field", ErrNoRRULE)
+	}
+	perDay := len(expr.hourList) * len(expr.minuteList) * len(expr.secondList)
+	if expr.lastWorkdayOfMonth && perDay > 1 {
+		return nil, fmt.Errorf("%w: last weekday (LW) with several times a day", ErrNoRRULE)
+	}
+
+	// dayRule holds the day parts of one rule. Monthly rules need FREQ=MONTHLY
+	// because of numbered BYDAY values or BYSETPOS.
+	type dayRule struct {
+		monthly bool
+		parts   []string
+	}
+	var days []dayRule
+	if expr.daysOfMonthRestricted {
+		var monthDays []string
+		for _, d := range toList(expr.daysOfMonth) {
+			monthDays = append(monthDays, strconv.Itoa(d))
+		}
+		if expr.lastDayOfMonth {
+			monthDays = append(monthDays, "-1")
+		}
+		if len(monthDays) > 0 {
+			days = append(days, dayRule{parts: []string{"BYMONTHDAY=" + strings.Join(monthDays, ",")}})
+		}
+		if expr.lastWorkdayOfMonth {
+			days = append(days, dayRule{monthly: true, parts: []string{"BYDAY=MO,TU,WE,TH,FR", "BYSETPOS=-1"}})
+		}
+	}
+	if expr.daysOfWeekRestricted {
+		var weekDays []string
+		for _, d := range toList(expr.daysOfWeek) {
+			weekDays = append(weekDays, rruleDays[d])
+		}
+		numbered := len(expr.specificWeekDaysOfWeek) > 0 || len(expr.lastWeekDaysOfWeek) > 0
+		for _, k := range toList(expr.specificWeekDaysOfWeek) {
+			weekDays = append(weekDays, strconv.Itoa(k/daysPerWeek+1)+rruleDays[k%daysPerWeek])
+		}
+		for _, d := range toList(expr.lastWeekDaysOfWeek) {
+			weekDays = append(weekDays, "-1"+rruleDays[d])
+		}
+		days = append(days, dayRule{monthly: numbered, parts: []string{"BYDAY=" + strings.Join(weekDays, ",")}})
+	}
+	if len(days) == 0 {
+		days = []dayRule{{}}
+	}
+
+	rules := make([]string, 0, len(days))
+	for _, day := range days {
+		freq := "DAILY"
+		switch {
+		case day.monthly:
+			freq = "MONTHLY"
+		case len(expr.hourList) < 24:
+		case len(expr.minuteList) < 60:
+			freq = "HOURLY"
+		case len(expr.secondList) < 60:
+			freq = "MINUTELY"
+		default:
+			freq = "SECONDLY"
+		}
+		parts := []string{"FREQ=" + freq}
+		if len(expr.monthList) < 12 {
+			parts = append(parts, "BYMONTH="+joinInts(expr.monthList))
+		}
+		parts = append(parts, day.parts...)
+		if freq == "DAILY" || freq == "MONTHLY" {
+			parts = append(parts, "BYHOUR="+joinInts(expr.hourList))
+		}
+		if freq != "MINUTELY" && freq != "SECONDLY" {
+			parts = append(parts, "BYMINUTE="+joinInts(expr.minuteList))
+		}
+		if freq != "SECONDLY" && !slices.Equal(expr.secondList, []int{0}) {
+			parts = append(parts, "BYSECOND="+joinInts(expr.secondList))
+		}
+		rules = append(rules, strings.Join(parts, ";"))
+	}
+	return rules, nil
+}
+
+// Recurrence is a cron expression as an RFC 5545 recurrence set.
+type Recurrence struct {
+	// Start is the first occurrence, used as DTSTART. It is zero if the
+	// expression never fires after the start of the window.
+	Start time.Time
+	// RRules are the recurrence rules from ToRRULE. They are unbounded.
+	RRules []string
+	// RDates lists the occurrences in the window when the expression has no
+	// equivalent rules.
+	RDates []time.Time
+}
+
+// Recurrence returns the recurrence set of the cron expression starting at
+// the first occurrence at or after from, in the location of from. When
+// ToRRULE fails, the set falls back to the occurrences before to, listed as
+// RDATEs.
+func (expr *Expression) Recurrence(from, to time.Time) Recurrence {
+	start := expr.Next(from.Add(-time.Nanosecond))
+	if start.IsZero() {
+		return Recurrence{}
+	}
+	rec := Recurrence{Start: start}
+	if rules, err := expr.ToRRULE(); err == nil {
+		rec.RRules = rules
+		return rec
+	}
+	for t := start; !t.IsZero() && t.Before(to); t = expr.Next(t) {
+		rec.RDates = append(rec.RDates, t)
+	}
+	return rec
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
+
+// ErrNoCron is returned by FromRRULE for rules that no cron expression
+// reproduces exactly.
+var ErrNoCron = errors.New("no equivalent cron expression")
+
+// FromRRULE converts an RFC 5545 RRULE, with or without the "RRULE:"
+// prefix, to a cron expression firing at the same times in the location of
+// dtstart. Parts the rule leaves out are taken from dtstart, as RFC 5545
+// specifies. BYMONTHDAY=-1 becomes L, BYDAY=-1FR 5L and BYDAY=2MO 1#2;
+// BYSETPOS is understood where cron has an equivalent, such as
+// BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1 for LW.
+//
+// Rules cron cannot express, such as FREQ=WEEKLY;INTERVAL=2, COUNT or UNTIL,
+// return an error wrapping ErrNoCron that names the offending part.
+func FromRRULE(rrule string, dtstart time.Time) (*Expression, error) {
+	r, err := parseRRULE(rrule)
+	if err != nil {
+		return nil, err
+	}
+	fail := func(format string, args ...any) (*Expression, error) {
+		return nil, fmt.Errorf("%w: "+format, append([]any{ErrNoCron}, args...)...)
+	}
+	switch {
+	case r.count > 0:
+		return fail("COUNT limits the number of runs")
+	case !r.until.IsZero():
+		return fail("UNTIL ends the schedule")
+	case r.freq == "WEEKLY" && r.interval > 1:
+		return fail("INTERVAL=%d with FREQ=WEEKLY", r.interval)
+	case r.freq == "DAILY" && r.interval > 1:
+		return fail("INTERVAL=%d with FREQ=DAILY, as months differ in length", r.interval)
+	case len(r.byMonthDay) > 0 && len(r.byDay) > 0:
+		return fail("BYMONTHDAY with BYDAY matches days satisfying both; cron matches either")
+	}
+	finer := func(freq string) bool {
+		return slices.Index(rruleFreqs, r.freq) >= slices.Index(rruleFreqs, freq)
+	}
+
+	// Time fields.
+	second, err := r.timeField("SECONDLY", r.bySecond, dtstart.Second(), 60, finer("SECONDLY"))
+	if err != nil {
+		return nil, err
+	}
+	minute, err := r.timeField("MINUTELY", r.byMinute, dtstart.Minute(), 60, finer("MINUTELY"))
+	if err != nil {
+		return nil, err
+	}
+	hour, err := r.timeField("HOURLY", r.byHour, dtstart.Hour(), 24, finer("HOURLY"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Month and year fields.
+	month, year := "*", "*"
+	if len(r.byMonth) > 0 {
+		month = joinInts(r.byMonth)
+	}
+	switch {
+	case r.freq == "MONTHLY" && r.interval > 1:
+		if 12%r.interval != 0 || len(r.byMonth) > 0 {
+			return fail("INTERVAL=%d with FREQ=MONTHLY", r.interval)
+		}
+		month = fmt.Sprintf("%d/%d", (int(dtstart.Month())-1)%r.interval+1, r.interval)
+	case r.freq == "YEARLY" && r.interval > 1:
+		year = fmt.Sprintf("%d/%d", dtstart.Year(), r.interval)
+	}
+	if r.freq == "YEARLY" && len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		month = strconv.Itoa(int(dtstart.Month()))
+	}
+
+	// Day fields.
+	dom, dow := "*", "*"
+	switch {
+	case len(r.bySetPos) > 0:
+		if dow, err = r.setPosField(); err != nil {
+			return nil, err
+		}
+		if dow == "LW" || dow == "1W" {
+			dom, dow = dow, "*"
+		}
+	case len(r.byMonthDay) > 0:
+		var days []string
+		for _, d := range r.byMonthDay {
+			switch {
+			case d == -1:
+				days = append(days, "L")
+			case d < 0:
+				return fail("BYMONTHDAY=%d", d)
+			default:
+				days = append(days, strconv.Itoa(d))
+			}
+		}
+		dom = strings.Join(days, ",")
+	case len(r.byDay) > 0:
+		var days []string
+		for _, wd := range r.byDay {
+			switch {
+			case wd.n == 0:
+				days = append(days, strconv.Itoa(int(wd.day)))
+			case r.freq != "MONTHLY" && (r.freq != "YEARLY" || len(r.byMonth) == 0):
+				return fail("BYDAY=%d%s outside a month", wd.n, rruleDays[wd.day])
+			case wd.n == -1:
+				days = append(days, fmt.Sprintf("%dL", wd.day))
+			case wd.n >= 1 && wd.n <= 5:
+				days = append(days, fmt.Sprintf("%d#%d", wd.day, wd.n))
+			default:
+				return fail("BYDAY=%d%s", wd.n, rruleDays[wd.day])
+			}
+		}
+		dow = strings.Join(days, ",")
+	case r.freq == "YEARLY" || r.freq == "MONTHLY":
+		dom = strconv.Itoa(dtstart.Day())
+	case r.freq == "WEEKLY":
+		dow = strconv.Itoa(int(dtstart.Weekday()))
+	}
+
+	fields := []string{minute, hour, dom, month, dow}
+	if second != "0" || year != "*" {
+		fields = append([]string{second}, append(fields, year)...)
+	}
+	expr, err := Parse(strings.Join(fields, " "))
+	if err != nil {
+		return fail("%v", err)
+	}
+	if err := expr.checkRRULE(r, dtstart); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// timeField returns the cron field for a time part of the rule. Frequencies
+// at or finer than the part's own repeat it every INTERVAL units, which cron
+// expresses as a step when INTERVAL divides the unit's range.
+func (r *rrule) timeField(freq string, by []int, start, size int, repeats bool) (string, error) {
+	switch {
+	case !repeats && len(by) > 0:
+		return joinInts(by), nil
+	case !repeats:
+		return strconv.Itoa(start), nil
+	case r.freq != freq || r.interval == 1:
+		if len(by) > 0 {
+			return joinInts(by), nil
+		}
+		return "*", nil
+	case len(by) > 0 || size%r.interval != 0:
+		return "", fmt.Errorf("%w: INTERVAL=%d with FREQ=%s", ErrNoCron, r.interval, freq)
+	default:
+		return fmt.Sprintf("%d/%d", start%r.interval, r.interval), nil
+	}
+}
+
+// setPosField converts the BYSETPOS forms cron can express: the first or
+// last weekday of the month, and the n-th or last given weekday.
+func (r *rrule) setPosField() (string, error) {
+	fail := fmt.Errorf("%w: BYSETPOS with this BYDAY", ErrNoCron)
+	if r.freq != "MONTHLY" || len(r.bySetPos) != 1 || len(r.byMonthDay) > 0 ||
+		len(r.byHour) > 1 || len(r.byMinute) > 1 || len(r.bySecond) > 1 {
+		return "", fail
+	}
+	pos := r.bySetPos[0]
+	var days []time.Weekday
+	for _, wd := range r.byDay {
+		if wd.n != 0 {
+			return "", fail
+		}
+		days = append(days, wd.day)
+	}
+	slices.Sort(days)
+	switch {
+	case slices.Equal(days, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}):
+		switch pos {
+		case 1:
+			return "1W", nil
+		case -1:
+			return "LW", nil
+		}
+	case len(days) == 1:
+		switch {
+		case pos == -1:
+			return fmt.Sprintf("%dL", days[0]), nil
+		case pos >= 1 && pos <= 5:
+			return fmt.Sprintf("%d#%d", days[0], pos), nil
+		}
+	}
+	return "", fail
+}
+
+// checkRRULE verifies that the expression fires exactly when the rule does
+// over a window after dtstart, guarding the conversion against cases the
+// field mapping does not foresee.
+func (expr *Expression) checkRRULE(r *rrule, dtstart time.Time) error {
+	window := map[string]time.Duration{
+		"SECONDLY": time.Hour,
+		"MINUTELY": 2 * 24 * time.Hour,
+		"HOURLY":   60 * 24 * time.Hour,
+	}[r.freq]
+	to := dtstart.Add(window)
+	if window == 0 {
+		to = dtstart.AddDate(4*r.interval, 0, 0)
+	}
+	want := r.between(dtstart, dtstart, to)
+	i := 0
+	for t := expr.Next(dtstart.Add(-time.Nanosecond)); !t.IsZero() && t.Before(to); t = expr.Next(t) {
+		if secondOccurrence(t) {
+			// RFC 5545 runs a repeated wall-clock time once.
+			continue
+		}
+		if i >= len(want) || !t.Equal(want[i]) {
+			return fmt.Errorf("%w: the rule does not fire at %v", ErrNoCron, t)
+		}
+		i++
+	}
+	if i < len(want) {
+		return fmt.Errorf("%w: the rule fires at %v", ErrNoCron, want[i])
+	}
+	return nil
+}
+
+// secondOccurrence reports whether t is the second occurrence of a wall-clock
+// time repeated when the clocks fall back.
+func secondOccurrence(t time.Time) bool {
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return false
+	}
+	_, offset := t.Zone()
+	_, before := start.Add(-time.Nanosecond).Zone()
+	return t.Sub(start) < time.Duration(before-offset)*time.Second
+}
@@ -0,0 +1,364 @@
+package cronexpr
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rrule is a parsed RFC 5545 recurrence rule. It supports the parts cron
+// schedules can use: FREQ, INTERVAL, UNTIL, COUNT, WKST, BYMONTH,
+// BYMONTHDAY, BYDAY, BYHOUR, BYMINUTE, BYSECOND and BYSETPOS.
+type rrule struct {
+	freq     string
+	interval int
+	count    int
+	until    time.Time // zero if unbounded
+	// untilWall reports that until is a wall-clock time in the location of
+	// DTSTART rather than a UTC instant.
+	untilWall  bool
+	wkst       time.Weekday
+	byMonth    []int
+	byMonthDay []int
+	byDay      []weekdayNum
+	byHour     []int
+	byMinute   []int
+	bySecond   []int
+	bySetPos   []int
+}
+
+// weekdayNum is a BYDAY value such as MO, 2MO or -1FR. A zero n matches every
+// such weekday of the period.
+type weekdayNum struct {
+	n   int
+	day time.Weekday
+}
+
+// rruleFreqs are the supported FREQ values, coarsest first.
+var rruleFreqs = []string{"YEARLY", "MONTHLY", "WEEKLY", "DAILY", "HOURLY", "MINUTELY", "SECONDLY"}
+
+// parseRRULE parses an RRULE value, with or without the "RRULE:" prefix.
+func parseRRULE(s string) (*rrule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &rrule{interval: 1, wkst: time.Monday}
+	for part := range strings.SplitSeq(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed RRULE part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.freq = strings.ToUpper(value)
+			if !slices.Contains(rruleFreqs, r.freq) {
+				return nil, fmt.Errorf("unknown RRULE frequency %q", value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("interval %d", r.interval)
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+		case "UNTIL":
+			r.until, r.untilWall, err = parseRRULETime(value)
+		case "WKST":
+			var wd weekdayNum
+			if wd, err = parseWeekdayNum(value); err == nil {
+				r.wkst = wd.day
+			}
+		case "BYMONTH":
+			r.byMonth, err = parseRRULEInts(value, 1, 12)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRULEInts(value, -31, 31)
+		case "BYDAY":
+			for v := range strings.SplitSeq(value, ",") {
+				var wd weekdayNum
+				if wd, err = parseWeekdayNum(v); err != nil {
+					break
+				}
+				r.byDay = append(r.byDay, wd)
+			}
+		case "BYHOUR":
+			r.byHour, err = parseRRULEInts(value, 0, 23)
+		case "BYMINUTE":
+			r.byMinute, err = parseRRULEInts(value, 0, 59)
+		case "BYSECOND":
+			r.bySecond, err = parseRRULEInts(value, 0, 59)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRULEInts(value, -366, 366)
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE part %s: %w", part, err)
+		}
+	}
+	if r.freq == "" {
+		return nil, fmt.Errorf("RRULE without FREQ")
+	}
+	return r, nil
+}
+
+// parseRRULETime parses a DATE or DATE-TIME value. A trailing Z makes it a
+// UTC instant; otherwise it is a wall-clock time.
+func parseRRULETime(s string) (t time.Time, wall bool, err error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, !strings.HasSuffix(s, "Z"), nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("malformed time %q", s)
+}
+
+func parseWeekdayNum(s string) (weekdayNum, error) {
+	if len(s) < 2 {
+		return weekdayNum{}, fmt.Errorf("malformed weekday %q", s)
+	}
+	day := slices.Index(rruleDays, strings.ToUpper(s[len(s)-2:]))
+	if day < 0 {
+		return weekdayNum{}, fmt.Errorf("malformed weekday %q", s)
+	}
+	wd := weekdayNum{day: time.Weekday(day)}
+	if prefix := s[:len(s)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -53 || n > 53 {
+			return weekdayNum{}, fmt.Errorf("malformed weekday %q", s)
+		}
+		wd.n = n
+	}
+	return wd, nil
+}
+
+func parseRRULEInts(s string, lo, hi int) ([]int, error) {
+	var values []int
+	for v := range strings.SplitSeq(s, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < lo || n > hi || (n == 0 && lo < 0) {
+			return nil, fmt.Errorf("value %q out of range", v)
+		}
+		values = append(values, n)
+	}
+	slices.Sort(values)
+	return slices.Compact(values), nil
+}
+
+// between returns the occurrences of the rule started at dtstart that fall
+// in [from, to), in the location of dtstart. Wall-clock times skipped by a
+// daylight saving transition run after the jump, as RFC 5545 requires, and
+// repeated ones run once.
+func (r *rrule) between(dtstart, from, to time.Time) []time.Time {
+	loc := dtstart.Location()
+	start := toWall(dtstart)
+	// Occurrences may land up to a day away from their wall time in UTC;
+	// stop generating periods safely past the end of the window.
+	end := toWall(to.In(loc)).AddDate(0, 0, 1)
+
+	var out []time.Time
+	emitted := 0
+	for k := 0; ; k++ {
+		period := r.periodStart(start, k*r.interval)
+		if period.After(end) || (!r.until.IsZero() && r.untilWall && period.After(r.until)) {
+			break
+		}
+		for _, wall := range r.expand(start, period) {
+			if wall.Before(start) {
+				continue
+			}
+			t := wallDate(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), loc)
+			if !r.until.IsZero() {
+				if (r.untilWall && wall.After(r.until)) || (!r.untilWall && t.After(r.until)) {
+					return compactTimes(out)
+				}
+			}
+			emitted++
+			if r.count > 0 && emitted > r.count {
+				return compactTimes(out)
+			}
+			if !t.Before(from) && t.Before(to) {
+				out = append(out, t)
+			}
+		}
+	}
+	return compactTimes(out)
+}
+
+// periodStart returns the start of the k-th period of the rule after the one
+// containing start.
+func (r *rrule) periodStart(start time.Time, k int) time.Time {
+	y, m, d := start.Date()
+	switch r.freq {
+	case "YEARLY":
+		return time.Date(y+k, 1, 1, 0, 0, 0, 0, time.UTC)
+	case "MONTHLY":
+		return time.Date(y, m+time.Month(k), 1, 0, 0, 0, 0, time.UTC)
+	case "WEEKLY":
+		back := (int(start.Weekday()) - int(r.wkst) + daysPerWeek) % daysPerWeek
+		return time.Date(y, m, d-back+daysPerWeek*k, 0, 0, 0, 0, time.UTC)
+	case "DAILY":
+		return time.Date(y, m, d+k, 0, 0, 0, 0, time.UTC)
+	case "HOURLY":
+		return start.Truncate(time.Hour).Add(time.Duration(k) * time.Hour)
+	case "MINUTELY":
+		return start.Truncate(time.Minute).Add(time.Duration(k) * time.Minute)
+	default:
+		return start.Add(time.Duration(k) * time.Second)
+	}
+}
+
+// expand returns the wall-clock occurrences of the rule in the period
+// starting at period, in order, after BYSETPOS.
+func (r *rrule) expand(start, period time.Time) []time.Time {
+	var dates []time.Time
+	switch r.freq {
+	case "YEARLY":
+		for d := period; d.Year() == period.Year(); d = d.AddDate(0, 0, 1) {
+			dates = append(dates, d)
+		}
+	case "MONTHLY":
+		for d := period; d.Month() == period.Month(); d = d.AddDate(0, 0, 1) {
+			dates = append(dates, d)
+		}
+	case "WEEKLY":
+		for i := range daysPerWeek {
+			dates = append(dates, period.AddDate(0, 0, i))
+		}
+	default:
+		y, m, d := period.Date()
+		dates = []time.Time{time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+	}
+	dates = slices.DeleteFunc(dates, func(d time.Time) bool { return !r.matchesDate(start, d) })
+
+	hours := r.timeValues(r.byHour, start.Hour(), period.Hour(), "HOURLY")
+	minutes := r.timeValues(r.byMinute, start.Minute(), period.Minute(), "MINUTELY")
+	seconds := r.timeValues(r.bySecond, start.Second(), period.Second(), "SECONDLY")
+	var set []time.Time
+	for _, d := range dates {
+		for _, h := range hours {
+			for _, mi := range minutes {
+				for _, s := range seconds {
+					set = append(set, time.Date(d.Year(), d.Month(), d.Day(), h, mi, s, 0, time.UTC))
+				}
+			}
+		}
+	}
+	if len(r.bySetPos) == 0 {
+		return set
+	}
+	var picked []time.Time
+	for _, pos := range r.bySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(set) + pos
+		}
+		if i >= 0 && i < len(set) {
+			picked = append(picked, set[i])
+		}
+	}
+	slices.SortFunc(picked, time.Time.Compare)
+	return slices.CompactFunc(picked, time.Time.Equal)
+}
+
+// timeValues returns the values of a time part for a period. Frequencies at
+// or finer than fixedFrom fix the part to the period's value, which the BY
+// list then limits; coarser ones take the BY list, or the value of DTSTART.
+func (r *rrule) timeValues(by []int, startValue, periodValue int, fixedFrom string) []int {
+	if slices.Index(rruleFreqs, r.freq) >= slices.Index(rruleFreqs, fixedFrom) {
+		if len(by) > 0 && !slices.Contains(by, periodValue) {
+			return nil
+		}
+		return []int{periodValue}
+	}
+	if len(by) > 0 {
+		return by
+	}
+	return []int{startValue}
+}
+
+// matchesDate reports whether the BYMONTH, BYMONTHDAY and BYDAY parts, or
+// the defaults taken from DTSTART, allow the date d.
+func (r *rrule) matchesDate(start, d time.Time) bool {
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, int(d.Month())) {
+		return false
+	}
+	daysInMonth := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if len(r.byMonthDay) > 0 && !slices.ContainsFunc(r.byMonthDay, func(v int) bool {
+		return v == d.Day() || v == d.Day()-daysInMonth-1
+	}) {
+		return false
+	}
+	if len(r.byDay) > 0 && !slices.ContainsFunc(r.byDay, func(wd weekdayNum) bool {
+		return r.matchesWeekday(wd, d, daysInMonth)
+	}) {
+		return false
+	}
+	if len(r.byMonthDay) > 0 || len(r.byDay) > 0 {
+		return true
+	}
+	switch r.freq {
+	case "YEARLY":
+		return d.Day() == start.Day() && (len(r.byMonth) > 0 || d.Month() == start.Month())
+	case "MONTHLY":
+		return d.Day() == start.Day()
+	case "WEEKLY":
+		return d.Weekday() == start.Weekday()
+	}
+	return true
+}
+
+// matchesWeekday reports whether d is the weekday wd. A numbered weekday
+// counts within the month, or within the year for a YEARLY rule without
+// BYMONTH.
+func (r *rrule) matchesWeekday(wd weekdayNum, d time.Time, daysInMonth int) bool {
+	if d.Weekday() != wd.day {
+		return false
+	}
+	if wd.n == 0 {
+		return true
+	}
+	day, days := d.Day(), daysInMonth
+	if r.freq == "YEARLY" && len(r.byMonth) == 0 {
+		day = d.YearDay()
+		days = time.Date(d.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	if wd.n > 0 {
+		return (day-1)/daysPerWeek+1 == wd.n
+	}
+	return (days-day)/daysPerWeek+1 == -wd.n
+}
+
+// occurrences returns the occurrences of the recurrence set in [from, to).
+func (rec Recurrence) occurrences(from, to time.Time) ([]time.Time, error) {
+	if rec.Start.IsZero() {
+		return nil, nil
+	}
+	var out []time.Time
+	if !rec.Start.Before(from) && rec.Start.Before(to) {
+		out = append(out, rec.Start)
+	}
+	for _, s := range rec.RRules {
+		r, err := parseRRULE(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r.between(rec.Start, from, to)...)
+	}
+	for _, t := range rec.RDates {
+		if !t.Before(from) && t.Before(to) {
+			out = append(out, t)
+		}
+	}
+	return compactTimes(out), nil
+}
+
+// toWall returns the wall-clock time of t as the same wall time in UTC.
+func toWall(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func compactTimes(times []time.Time) []time.Time {
+	slices.SortFunc(times, time.Time.Compare)
+	return slices.CompactFunc(times, time.Time.Equal)
+}
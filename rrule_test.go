@@ -0,0 +1,225 @@
+package cronexpr
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestToRRULE(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"0 9 * * *", []string{"FREQ=DAILY;BYHOUR=9;BYMINUTE=0"}},
+		{"*/15 * * * *", []string{"FREQ=HOURLY;BYMINUTE=0,15,30,45"}},
+		{"* * * * *", []string{"FREQ=MINUTELY"}},
+		{"*/10 * * * * * *", []string{"FREQ=MINUTELY;BYSECOND=0,10,20,30,40,50"}},
+		{"* * * * * * *", []string{"FREQ=SECONDLY"}},
+		{"0 9 * * MON-FRI", []string{"FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0"}},
+		{"0 17 * * 5L", []string{"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0"}},
+		{"0 9 * * 1#2", []string{"FREQ=MONTHLY;BYDAY=2MO;BYHOUR=9;BYMINUTE=0"}},
+		{"0 0 L * *", []string{"FREQ=DAILY;BYMONTHDAY=-1;BYHOUR=0;BYMINUTE=0"}},
+		{"0 0 LW * *", []string{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;BYHOUR=0;BYMINUTE=0"}},
+		{"30 6 1 1,7 *", []string{"FREQ=DAILY;BYMONTH=1,7;BYMONTHDAY=1;BYHOUR=6;BYMINUTE=30"}},
+		{"0 0 13 * 5", []string{
+			"FREQ=DAILY;BYMONTHDAY=13;BYHOUR=0;BYMINUTE=0",
+			"FREQ=DAILY;BYDAY=FR;BYHOUR=0;BYMINUTE=0",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := MustParse(tt.expr).ToRRULE()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ToRRULE() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 9 15W * *", "*/30 9 LW * *", "0 0 1 1 * 2030"} {
+		if _, err := MustParse(expr).ToRRULE(); !errors.Is(err, ErrNoRRULE) {
+			t.Errorf("(%q).ToRRULE() error = %v, want ErrNoRRULE", expr, err)
+		}
+	}
+}
+
+// TestRecurrenceRoundTrip checks that the recurrence set of an expression,
+// evaluated by the RRULE evaluator, gives exactly the times of Next, apart
+// from the second copy of a repeated hour.
+func TestRecurrenceRoundTrip(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	exprs := []string{
+		"0 9 * * *",
+		"*/15 * * * *",
+		"0 9-17/2 * * MON-FRI",
+		"0 17 * * 5L",
+		"0 9 * * 1#2,3#4",
+		"0 0 L * *",
+		"0 0 LW * *",
+		"0 12 29 2 *",
+		"0 0 13 * 5",
+		"30 2 * * *",
+		"30 1 * 11 SUN",
+		"0 9 15W * *",
+		"*/20 * 9 3 *",
+		"15 10 L 2 *",
+	}
+	for _, loc := range []*time.Location{time.UTC, ny} {
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, loc)
+		to := time.Date(2028, 1, 1, 0, 0, 0, 0, loc)
+		for _, s := range exprs {
+			t.Run(loc.String()+"/"+s, func(t *testing.T) {
+				expr := MustParse(s)
+				var want []time.Time
+				for next := expr.Next(from.Add(-time.Nanosecond)); !next.IsZero() && next.Before(to); next = expr.Next(next) {
+					// RFC 5545 runs a repeated wall-clock time once; * and
+					// step schedules run in both copies of the hour.
+					if !secondOccurrence(next) {
+						want = append(want, next)
+					}
+				}
+				rec := expr.Recurrence(from, to)
+				got, err := rec.occurrences(from, to)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !slices.EqualFunc(got, want, time.Time.Equal) {
+					t.Errorf("RRULE %q gives %d times, Next gives %d", rec.RRules, len(got), len(want))
+					for i := range min(len(got), len(want)) {
+						if !got[i].Equal(want[i]) {
+							t.Errorf("first difference at %d: %v, want %v", i, got[i], want[i])
+							break
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestParseRRULE(t *testing.T) {
+	dtstart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC) // Monday
+	tests := []struct {
+		rule string
+		want []string
+	}{
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4", []string{"2026-01-05", "2026-01-07", "2026-01-19", "2026-01-21"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20260401T000000Z", []string{"2026-01-31", "2026-02-28", "2026-03-31"}},
+		{"FREQ=YEARLY;BYDAY=20MO;COUNT=2", []string{"2026-05-18", "2027-05-17"}},
+		{"FREQ=YEARLY;COUNT=2", []string{"2026-01-05", "2027-01-05"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := parseRRULE(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, o := range r.between(dtstart, dtstart, dtstart.AddDate(3, 0, 0)) {
+				got = append(got, o.Format(time.DateOnly))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("occurrences = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, rule := range []string{"BYDAY=MO", "FREQ=FORTNIGHTLY", "FREQ=DAILY;BYWEEKNO=1", "FREQ=DAILY;BYHOUR=24", "FREQ=DAILY;BYDAY=XX"} {
+		if _, err := parseRRULE(rule); err == nil {
+			t.Errorf("parseRRULE(%q) succeeded", rule)
+		}
+	}
+}
+
+func TestFromRRULE(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	dtstart := time.Date(2026, 1, 5, 9, 30, 0, 0, ny) // Monday
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"RRULE:FREQ=DAILY", "30 9 * * *"},
+		{"FREQ=DAILY;BYHOUR=8,17;BYMINUTE=0", "0 8,17 * * *"},
+		{"FREQ=WEEKLY", "30 9 * * 1"},
+		{"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=7;BYMINUTE=15", "15 7 * * 1,3,5"},
+		{"FREQ=MONTHLY", "30 9 5 * *"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", "30 9 L * *"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,15", "30 9 1,15 * *"},
+		{"FREQ=MONTHLY;BYDAY=-1FR", "30 9 * * 5L"},
+		{"FREQ=MONTHLY;BYDAY=2MO,4MO", "30 9 * * 1#2,1#4"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "30 9 LW * *"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=1", "30 9 1W * *"},
+		{"FREQ=MONTHLY;BYDAY=TH;BYSETPOS=3", "30 9 * * 4#3"},
+		{"FREQ=MONTHLY;INTERVAL=3", "30 9 5 1/3 *"},
+		{"FREQ=YEARLY", "30 9 5 1 *"},
+		{"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH", "30 9 * 11 4#4"},
+		{"FREQ=YEARLY;INTERVAL=2;BYMONTH=6;BYMONTHDAY=1", "0 30 9 1 6 * 2026/2"},
+		{"FREQ=HOURLY", "30 * * * *"},
+		{"FREQ=HOURLY;INTERVAL=6", "30 3/6 * * *"},
+		{"FREQ=HOURLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9,10,11,12,13,14,15,16,17", "30 9-17 * * 1-5"},
+		{"FREQ=MINUTELY;INTERVAL=15", "0/15 * * * *"},
+		{"FREQ=SECONDLY;INTERVAL=20;BYHOUR=12", "0/20 * 12 * * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			expr, err := FromRRULE(tt.rule, dtstart)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := MustParse(tt.want)
+			got, wantTimes := expr.NextN(dtstart, 50), want.NextN(dtstart, 50)
+			if !slices.EqualFunc(got, wantTimes, time.Time.Equal) {
+				t.Errorf("FromRRULE fires at %v, want %v", got, wantTimes)
+			}
+		})
+	}
+
+	for _, rule := range []string{
+		"FREQ=WEEKLY;INTERVAL=2",
+		"FREQ=DAILY;INTERVAL=3",
+		"FREQ=DAILY;COUNT=10",
+		"FREQ=DAILY;UNTIL=20270101T000000Z",
+		"FREQ=MONTHLY;BYMONTHDAY=13;BYDAY=FR",
+		"FREQ=MONTHLY;BYMONTHDAY=-2",
+		"FREQ=MONTHLY;BYDAY=-2MO",
+		"FREQ=YEARLY;BYDAY=20MO",
+		"FREQ=MONTHLY;INTERVAL=5",
+		"FREQ=HOURLY;INTERVAL=5",
+		"FREQ=MONTHLY;BYDAY=MO,TU;BYSETPOS=2",
+	} {
+		if _, err := FromRRULE(rule, dtstart); !errors.Is(err, ErrNoCron) {
+			t.Errorf("FromRRULE(%q) error = %v, want ErrNoCron", rule, err)
+		}
+	}
+	if _, err := FromRRULE("FREQ=DAILY;BYWEEKNO=1", dtstart); err == nil || errors.Is(err, ErrNoCron) {
+		t.Errorf("FromRRULE with BYWEEKNO error = %v, want a parse error", err)
+	}
+}
+
+func TestFromRRULERoundTrip(t *testing.T) {
+	for _, src := range []string{"0 9 * * 1-5", "*/15 8-18 * * *", "0 0 L * *", "0 17 * * 5L", "30 6 1,15 */2 *", "0 12 * * 1#2"} {
+		expr := MustParse(src)
+		rules, err := expr.ToRRULE()
+		if err != nil || len(rules) != 1 {
+			t.Fatalf("ToRRULE(%q) = %v, %v", src, rules, err)
+		}
+		start := expr.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		back, err := FromRRULE(rules[0], start)
+		if err != nil {
+			t.Fatalf("FromRRULE(%q): %v", rules[0], err)
+		}
+		if got, want := back.NextN(start, 100), expr.NextN(start, 100); !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("%q round-trips through %q to different times", src, rules[0])
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package cronexpr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ConcurrencyPolicy decides what a Scheduler does when a job is due while a
+// previous run of it is still active, like the Kubernetes CronJob setting of
+// the same name.
+type ConcurrencyPolicy int
+
+const (
+	// AllowConcurrent starts the new run alongside the active ones.
+	AllowConcurrent ConcurrencyPolicy = iota
+	// ForbidConcurrent skips the new run while a previous run is active.
+	ForbidConcurrent
+	// ReplaceConcurrent cancels the context of the active runs and starts the
+	// new run once they have returned.
+	ReplaceConcurrent
+)
+
+// String returns "Allow", "Forbid" or "Replace".
+func (p ConcurrencyPolicy) String() string {
+	switch p {
+	case ForbidConcurrent:
+		return "Forbid"
+	case ReplaceConcurrent:
+		return "Replace"
+	}
+	return "Allow"
+}
+
+// Job is a unit of work run by a Scheduler on a cron schedule.
+type Job struct {
+	// ID identifies the job within its scheduler and in hooks.
+	ID string
+	// Schedule determines when the job runs.
+	Schedule *Expression
+	// Run does the work for the run scheduled at the given instant. ctx is
+	// cancelled when the run is replaced or the scheduler stops.
+	Run func(ctx context.Context, scheduled time.Time) error
+	// Concurrency sets how overlapping runs are handled. The zero value is
+	// AllowConcurrent.
+	Concurrency ConcurrencyPolicy
+	// CatchUp selects which runs missed while the scheduler was stopped are
+	// run when it starts, based on the RunState in the scheduler's Store.
+	// RunAll, the zero value, runs at most maxCatchUpRuns of them. Catch-up
+	// runs are subject to Concurrency like any other run.
+	CatchUp CatchUpPolicy
+}
+
+// maxCatchUpRuns bounds the missed runs started for a job under RunAll.
+const maxCatchUpRuns = 100
+
+// Hooks receive notifications about job runs from a Scheduler. Any of them
+// may be nil. They are called from scheduler goroutines and should return
+// promptly.
+type Hooks struct {
+	// OnSkip is called when the run scheduled at scheduled is skipped because
+	// a previous run is still active under ForbidConcurrent.
+	OnSkip func(id string, scheduled time.Time)
+	// OnReplace is called when active runs are cancelled to make way for the
+	// run scheduled at scheduled under ReplaceConcurrent.
+	OnReplace func(id string, scheduled time.Time)
+	// OnError is called when the run scheduled at scheduled returns an error,
+	// or when the Store fails to load or save the job's state. Store errors
+	// while loading report the zero time as scheduled.
+	OnError func(id string, scheduled time.Time, err error)
+}
+
+// Scheduler runs jobs at the instants computed by Next on their schedules.
+// Use NewScheduler to create one and Run to start it.
+type Scheduler struct {
+	clock Clock
+	hooks Hooks
+	store Store
+
+	mu     sync.Mutex
+	jobs   map[string]*jobState
+	runs   sync.WaitGroup
+	wake   chan struct{}
+	saveMu sync.Mutex // orders Store saves
+}
+
+// jobState tracks a job's next instant, its active runs and its run history.
+type jobState struct {
+	job      Job
+	next     time.Time
+	active   map[*jobRun]struct{}
+	state    RunState
+	caughtUp bool // state loaded and missed runs dispatched
+}
+
+// jobRun is a single active run of a job.
+type jobRun struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewScheduler returns an empty Scheduler.
+func NewScheduler(opts ...Option) *Scheduler {
+	cfg := newConfig(opts)
+	return &Scheduler{
+		clock: cfg.clock,
+		hooks: cfg.hooks,
+		store: cfg.store,
+		jobs:  make(map[string]*jobState),
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Add registers job with the scheduler. It may be called before or while the
+// scheduler runs. An error is returned if the job is incomplete or its ID is
+// already in use.
+func (s *Scheduler) Add(job Job) error {
+	switch {
+	case job.ID == "":
+		return errors.New("job ID is empty")
+	case job.Schedule == nil:
+		return fmt.Errorf("job %q has no schedule", job.ID)
+	case job.Run == nil:
+		return fmt.Errorf("job %q has no Run function", job.ID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; ok {
+		return fmt.Errorf("duplicate job ID %q", job.ID)
+	}
+	s.jobs[job.ID] = &jobState{
+		job:    job,
+		next:   job.Schedule.Next(s.clock.Now()),
+		active: make(map[*jobRun]struct{}),
+	}
+	s.notify()
+	return nil
+}
+
+// Remove unregisters the job with the given ID, reporting whether it was
+// present. Runs already in progress are left to finish.
+func (s *Scheduler) Remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		return false
+	}
+	delete(s.jobs, id)
+	s.notify()
+	return true
+}
+
+// Run starts due jobs until ctx is cancelled. It then cancels the context of
+// all active runs and waits for them to return before returning itself.
+//
+// With a Store, Run first loads each job's RunState and starts the runs its
+// CatchUp policy selects among those missed since the last recorded run. Jobs
+// added later are caught up the same way.
+func (s *Scheduler) Run(ctx context.Context) error {
+	defer s.runs.Wait()
+	for {
+		loaded := s.load()
+		s.mu.Lock()
+		now := s.clock.Now()
+		var earliest time.Time
+		var notes []func()
+		for _, js := range s.jobs {
+			if !js.caughtUp {
+				l, ok := loaded[js]
+				if s.store != nil && !ok {
+					// Added since the states were loaded: catch up next pass.
+					s.notify()
+					continue
+				}
+				notes = append(notes, s.catchUp(ctx, js, l, now)...)
+			}
+			if js.next.IsZero() {
+				continue
+			}
+			if !js.next.After(now) {
+				if note := s.dispatch(ctx, js, js.next); note != nil {
+					notes = append(notes, note)
+				}
+				js.next = js.job.Schedule.Next(now)
+				if js.next.IsZero() {
+					continue
+				}
+			}
+			if earliest.IsZero() || js.next.Before(earliest) {
+				earliest = js.next
+			}
+		}
+		s.mu.Unlock()
+		for _, note := range notes {
+			note()
+		}
+
+		var timer Timer
+		var fire <-chan time.Time
+		if !earliest.IsZero() {
+			timer = s.clock.NewTimer(earliest.Sub(now))
+			fire = timer.C()
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil
+		case <-fire:
+		case <-s.wake:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+	}
+}
+
+// notify wakes the Run loop so it recomputes the earliest instant. The
+// caller holds s.mu.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// loadedState is the run history of a job as read from the store, or the
+// error reading it.
+type loadedState struct {
+	state RunState
+	err   error
+}
+
+// load reads the run history of the jobs not yet caught up from the store.
+// It holds s.mu only to list the jobs, so that slow store reads do not block
+// Add, Remove or dispatch.
+func (s *Scheduler) load() map[*jobState]loadedState {
+	if s.store == nil {
+		return nil
+	}
+	s.mu.Lock()
+	var pending []*jobState
+	for _, js := range s.jobs {
+		if !js.caughtUp {
+			pending = append(pending, js)
+		}
+	}
+	s.mu.Unlock()
+
+	loaded := make(map[*jobState]loadedState, len(pending))
+	for _, js := range pending {
+		state, err := s.store.Load(js.job.ID)
+		loaded[js] = loadedState{state, err}
+	}
+	return loaded
+}
+
+// catchUp applies the run history of js loaded from the store and dispatches
+// the missed runs its policy selects. Runs at or after js.next are left to
+// the normal dispatch of js.next, so that an instant that came due between
+// Add and Run starts once. It returns the hook calls to make once the
+// caller, which holds s.mu, has released it.
+func (s *Scheduler) catchUp(ctx context.Context, js *jobState, l loadedState, now time.Time) (notes []func()) {
+	js.caughtUp = true
+	if s.store == nil {
+		return nil
+	}
+	id := js.job.ID
+	if l.err != nil {
+		if s.hooks.OnError != nil {
+			notes = append(notes, func() { s.hooks.OnError(id, time.Time{}, l.err) })
+		}
+		return notes
+	}
+	js.state = l.state
+	for _, t := range js.job.CatchUp.CatchUp(js.job.Schedule, l.state.catchUpFrom(), now, maxCatchUpRuns) {
+		if !js.next.IsZero() && !t.Before(js.next) {
+			break
+		}
+		if note := s.dispatch(ctx, js, t); note != nil {
+			notes = append(notes, note)
+		}
+	}
+	return notes
+}
+
+// record updates the run history of js with update and saves it.
+func (s *Scheduler) record(js *jobState, scheduled time.Time, update func(*RunState)) {
+	if s.store == nil {
+		return
+	}
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+	s.mu.Lock()
+	update(&js.state)
+	state := js.state
+	s.mu.Unlock()
+	if err := s.store.Save(js.job.ID, state); err != nil && s.hooks.OnError != nil {
+		s.hooks.OnError(js.job.ID, scheduled, err)
+	}
+}
+
+// dispatch starts the run of js scheduled at scheduled, applying the job's
+// concurrency policy. It returns the hook call reporting a skipped or
+// replaced run, if any, for the caller to make once it has released s.mu,
+// which it holds.
+func (s *Scheduler) dispatch(ctx context.Context, js *jobState, scheduled time.Time) (note func()) {
+	id := js.job.ID
+	var replaced []*jobRun
+	if len(js.active) > 0 {
+		switch js.job.Concurrency {
+		case ForbidConcurrent:
+			if s.hooks.OnSkip != nil {
+				note = func() { s.hooks.OnSkip(id, scheduled) }
+			}
+			return note
+		case ReplaceConcurrent:
+			for r := range js.active {
+				r.cancel()
+				replaced = append(replaced, r)
+			}
+			if s.hooks.OnReplace != nil {
+				note = func() { s.hooks.OnReplace(id, scheduled) }
+			}
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	r := &jobRun{cancel: cancel, done: make(chan struct{})}
+	js.active[r] = struct{}{}
+	s.runs.Add(1)
+	go func() {
+		defer s.runs.Done()
+		defer close(r.done)
+		defer cancel()
+		for _, old := range replaced {
+			<-old.done
+		}
+		s.record(js, scheduled, func(st *RunState) {
+			if scheduled.After(st.LastScheduled) {
+				st.LastScheduled = scheduled
+			}
+		})
+		err := js.job.Run(runCtx, scheduled)
+		s.mu.Lock()
+		delete(js.active, r)
+		s.mu.Unlock()
+		if runCtx.Err() == nil {
+			s.record(js, scheduled, func(st *RunState) {
+				if scheduled.After(st.LastCompleted) {
+					st.LastCompleted = scheduled
+				}
+			})
+		}
+		if err != nil && s.hooks.OnError != nil {
+			s.hooks.OnError(id, scheduled, err)
+		}
+	}()
+	return note
+}
@@ -0,0 +1,150 @@
+package cronexpr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/cronexprtest"
+)
+
+// startScheduler runs s until the test ends and returns a function that
+// advances clock to the next minute once the scheduler is waiting on it.
+func startScheduler(t *testing.T, s *cronexpr.Scheduler, clock *cronexprtest.FakeClock) (tick func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		if err := s.Run(ctx); err != nil {
+			t.Errorf("Run() = %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-stopped
+	})
+	return func() {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+	}
+}
+
+func TestScheduler_Concurrency(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	first, second := start.Add(time.Minute), start.Add(2*time.Minute)
+
+	tests := []struct {
+		policy        cronexpr.ConcurrencyPolicy
+		wantStarted   []time.Time
+		wantSkipped   []time.Time
+		wantReplaced  []time.Time
+		wantCancelled bool
+	}{
+		{policy: cronexpr.AllowConcurrent, wantStarted: []time.Time{first, second}},
+		{policy: cronexpr.ForbidConcurrent, wantStarted: []time.Time{first}, wantSkipped: []time.Time{second}},
+		{policy: cronexpr.ReplaceConcurrent, wantStarted: []time.Time{first, second}, wantReplaced: []time.Time{second}, wantCancelled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			clock := cronexprtest.NewFakeClock(start)
+			started := make(chan time.Time, 2)
+			cancelled := make(chan time.Time, 2)
+			skipped := make(chan time.Time, 1)
+			replaced := make(chan time.Time, 1)
+			release := make(chan struct{})
+			defer close(release)
+
+			s := cronexpr.NewScheduler(cronexpr.WithClock(clock), cronexpr.WithHooks(cronexpr.Hooks{
+				OnSkip:    func(_ string, at time.Time) { skipped <- at },
+				OnReplace: func(_ string, at time.Time) { replaced <- at },
+			}))
+			err := s.Add(cronexpr.Job{
+				ID:          "job",
+				Schedule:    cronexpr.MustParse("* * * * *"),
+				Concurrency: tt.policy,
+				Run: func(ctx context.Context, scheduled time.Time) error {
+					started <- scheduled
+					select {
+					case <-release:
+					case <-ctx.Done():
+						cancelled <- scheduled
+					}
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			tick := startScheduler(t, s, clock)
+
+			tick()
+			if got := <-started; !got.Equal(first) {
+				t.Fatalf("first run scheduled at %v, want %v", got, first)
+			}
+			tick()
+			if len(tt.wantSkipped) > 0 {
+				if got := <-skipped; !got.Equal(second) {
+					t.Errorf("OnSkip(%v), want %v", got, second)
+				}
+			}
+			if len(tt.wantReplaced) > 0 {
+				if got := <-replaced; !got.Equal(second) {
+					t.Errorf("OnReplace(%v), want %v", got, second)
+				}
+			}
+			if tt.wantCancelled {
+				if got := <-cancelled; !got.Equal(first) {
+					t.Errorf("cancelled run scheduled at %v, want %v", got, first)
+				}
+			}
+			if len(tt.wantStarted) > 1 {
+				if got := <-started; !got.Equal(second) {
+					t.Errorf("second run scheduled at %v, want %v", got, second)
+				}
+			}
+			// Let the scheduler settle on its next timer before checking
+			// that nothing else started.
+			clock.BlockUntil(1)
+			select {
+			case got := <-started:
+				t.Errorf("unexpected run scheduled at %v", got)
+			default:
+			}
+		})
+	}
+}
+
+func TestScheduler_Errors(t *testing.T) {
+	clock := cronexprtest.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	failed := make(chan error, 1)
+	s := cronexpr.NewScheduler(cronexpr.WithClock(clock), cronexpr.WithHooks(cronexpr.Hooks{
+		OnError: func(id string, _ time.Time, err error) { failed <- err },
+	}))
+	errBoom := errors.New("boom")
+	job := cronexpr.Job{
+		ID:       "fails",
+		Schedule: cronexpr.MustParse("* * * * *"),
+		Run:      func(context.Context, time.Time) error { return errBoom },
+	}
+	if err := s.Add(job); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(job); err == nil {
+		t.Error("Add() with duplicate ID succeeded")
+	}
+	if err := s.Add(cronexpr.Job{ID: "no-schedule", Run: job.Run}); err == nil {
+		t.Error("Add() without schedule succeeded")
+	}
+
+	tick := startScheduler(t, s, clock)
+	tick()
+	if err := <-failed; !errors.Is(err, errBoom) {
+		t.Errorf("OnError(%v), want %v", err, errBoom)
+	}
+	if !s.Remove("fails") {
+		t.Error("Remove() = false, want true")
+	}
+}
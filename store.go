@@ -0,0 +1,151 @@
+package cronexpr
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// RunState is the persisted run history of a job.
+type RunState struct {
+	// LastScheduled is the scheduled instant of the most recent run started.
+	LastScheduled time.Time `json:"lastScheduled"`
+	// LastCompleted is the scheduled instant of the most recent run that
+	// returned without its context being cancelled. It precedes LastScheduled
+	// while that run is active, or if it was interrupted.
+	LastCompleted time.Time `json:"lastCompleted"`
+}
+
+// catchUpFrom returns the instant after which scheduled runs count as missed.
+// A run that started but never completed counts as missed itself.
+func (st RunState) catchUpFrom() time.Time {
+	if st.LastCompleted.Before(st.LastScheduled) {
+		return st.LastScheduled.Add(-time.Nanosecond)
+	}
+	return st.LastScheduled
+}
+
+// Store persists the RunState of jobs by ID so that a restarted Scheduler
+// knows what already ran. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the state saved for id, or the zero RunState if there is
+	// none.
+	Load(id string) (RunState, error)
+	// Save records the state for id.
+	Save(id string, state RunState) error
+}
+
+// WithStore sets the Store a Scheduler uses to record runs and to catch up
+// on runs missed while it was stopped. Without a store nothing is caught up.
+func WithStore(st Store) Option {
+	return func(cfg *config) {
+		cfg.store = st
+	}
+}
+
+// MemoryStore is a Store that keeps state in memory only.
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[string]RunState
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: make(map[string]RunState)}
+}
+
+// Load returns the state saved for id.
+func (m *MemoryStore) Load(id string) (RunState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.states[id], nil
+}
+
+// Save records the state for id.
+func (m *MemoryStore) Save(id string, state RunState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[id] = state
+	return nil
+}
+
+// FileStore is a Store that keeps the state of all jobs in a single JSON
+// file. Every Save rewrites the file through a temporary file and a rename,
+// so a crash never leaves it partially written.
+type FileStore struct {
+	path string
+
+	mu     sync.Mutex
+	states map[string]RunState
+}
+
+// OpenFileStore returns a FileStore backed by the file at path, loading any
+// state already saved there. The file is created on the first Save.
+func OpenFileStore(path string) (*FileStore, error) {
+	fst := &FileStore{path: path, states: make(map[string]RunState)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fst, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fst.states); err != nil {
+		return nil, &fs.PathError{Op: "decode", Path: path, Err: err}
+	}
+	return fst, nil
+}
+
+// Load returns the state saved for id.
+func (fst *FileStore) Load(id string) (RunState, error) {
+	fst.mu.Lock()
+	defer fst.mu.Unlock()
+	return fst.states[id], nil
+}
+
+// Save records the state for id and rewrites the file.
+func (fst *FileStore) Save(id string, state RunState) error {
+	fst.mu.Lock()
+	defer fst.mu.Unlock()
+	prev, had := fst.states[id]
+	fst.states[id] = state
+	if err := fst.write(); err != nil {
+		if had {
+			fst.states[id] = prev
+		} else {
+			delete(fst.states, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// write atomically replaces the file with the current states. The caller
+// holds fst.mu.
+func (fst *FileStore) write() error {
+	data, err := json.MarshalIndent(fst.states, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(fst.path), filepath.Base(fst.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fst.path)
+}
@@ -0,0 +1,152 @@
+package cronexpr_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/cronexprtest"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+	want := cronexpr.RunState{
+		LastScheduled: time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC),
+		LastCompleted: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	st, err := cronexpr.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := st.Load("job"); err != nil || got != (cronexpr.RunState{}) {
+		t.Fatalf("Load() on empty store = %v, %v", got, err)
+	}
+	if err := st.Save("job", want); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := cronexpr.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := reopened.Load("job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.LastScheduled.Equal(want.LastScheduled) || !got.LastCompleted.Equal(want.LastCompleted) {
+		t.Errorf("Load() after reopen = %+v, want %+v", got, want)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d entries, want only the state file", len(entries))
+	}
+}
+
+func TestFileStore_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cronexpr.OpenFileStore(path); err == nil {
+		t.Error("OpenFileStore() on corrupt file succeeded")
+	}
+}
+
+// savingStore reports every state saved to the embedded MemoryStore.
+type savingStore struct {
+	*cronexpr.MemoryStore
+	saved chan cronexpr.RunState
+}
+
+func (st savingStore) Save(id string, state cronexpr.RunState) error {
+	err := st.MemoryStore.Save(id, state)
+	st.saved <- state
+	return err
+}
+
+func TestScheduler_CatchUp(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 1, 1, 0, min, 0, 0, time.UTC) }
+	tests := []struct {
+		name   string
+		policy cronexpr.CatchUpPolicy
+		state  cronexpr.RunState
+		run    int // minute the scheduler starts, after the job is added at 17
+		want   []time.Time
+	}{
+		{"RunAll", cronexpr.RunAll, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(5)}, 17, []time.Time{at(10), at(15)}},
+		{"RunOnce", cronexpr.RunOnce, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(5)}, 17, []time.Time{at(15)}},
+		{"Skip", cronexpr.Skip, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(5)}, 17, nil},
+		{"Interrupted", cronexpr.RunAll, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(0)}, 17, []time.Time{at(5), at(10), at(15)}},
+		{"NeverRan", cronexpr.RunAll, cronexpr.RunState{}, 17, nil},
+		{"DueSinceAdd", cronexpr.RunAll, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(5)}, 21, []time.Time{at(10), at(15), at(20)}},
+		{"DueSinceAddOnce", cronexpr.RunOnce, cronexpr.RunState{LastScheduled: at(5), LastCompleted: at(5)}, 21, []time.Time{at(20)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := cronexprtest.NewFakeClock(at(17))
+			store := savingStore{cronexpr.NewMemoryStore(), make(chan cronexpr.RunState, 20)}
+			if err := store.MemoryStore.Save("job", tt.state); err != nil {
+				t.Fatal(err)
+			}
+			ran := make(chan time.Time, 10)
+			s := cronexpr.NewScheduler(cronexpr.WithClock(clock), cronexpr.WithStore(store))
+			err := s.Add(cronexpr.Job{
+				ID:       "job",
+				Schedule: cronexpr.MustParse("*/5 * * * *"),
+				CatchUp:  tt.policy,
+				Run: func(_ context.Context, scheduled time.Time) error {
+					ran <- scheduled
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			clock.Advance(at(tt.run).Sub(at(17)))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				defer close(stopped)
+				_ = s.Run(ctx)
+			}()
+			clock.BlockUntil(1)
+			if len(tt.want) > 0 {
+				// Wait until the last catch-up run has been recorded.
+				last := tt.want[len(tt.want)-1]
+				for state := range store.saved {
+					if state.LastCompleted.Equal(last) {
+						break
+					}
+				}
+			}
+			cancel()
+			<-stopped
+			close(ran)
+
+			var got []time.Time
+			for scheduled := range ran {
+				got = append(got, scheduled)
+			}
+			slices.SortFunc(got, time.Time.Compare)
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("caught up %v, want %v", got, tt.want)
+			}
+
+			state, _ := store.Load("job")
+			if len(tt.want) > 0 && !state.LastScheduled.Equal(tt.want[len(tt.want)-1]) {
+				t.Errorf("LastScheduled = %v, want %v", state.LastScheduled, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
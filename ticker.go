@@ -0,0 +1,146 @@
+package cronexpr
+
+import (
+	"sync"
+	"time"
+)
+
+// TickPolicy determines what a Ticker does with a tick when the previous one
+// has not been received yet.
+type TickPolicy int
+
+const (
+	// DropTicks discards the new tick and keeps the pending one, as
+	// time.Ticker does.
+	DropTicks TickPolicy = iota
+	// CoalesceTicks replaces the pending tick with the new one, so a slow
+	// reader always receives the most recent scheduled instant.
+	CoalesceTicks
+)
+
+// Ticker delivers the instants of a cron schedule on a channel, the way
+// time.Ticker does for a fixed period. Use NewTicker to create one.
+type Ticker struct {
+	// C receives each scheduled instant once it has been reached. It has a
+	// buffer of one tick and is never closed.
+	C <-chan time.Time
+
+	c      chan time.Time
+	clock  Clock
+	policy TickPolicy
+
+	mu   sync.Mutex
+	stop chan struct{} // closed to halt the running goroutine; nil when halted
+	done chan struct{} // closed when the running goroutine exits
+}
+
+// NewTicker returns a Ticker that sends the instants of expr, as computed by
+// Next, on its channel. The ticker re-arms after every tick and stops by
+// itself when the schedule has no further instants; see Done.
+func NewTicker(expr *Expression, opts ...Option) *Ticker {
+	cfg := newConfig(opts)
+	c := make(chan time.Time, 1)
+	tk := &Ticker{
+		C:      c,
+		c:      c,
+		clock:  cfg.clock,
+		policy: cfg.tickPolicy,
+	}
+	tk.start(expr)
+	return tk
+}
+
+// Stop turns off the ticker and discards any tick not yet received. No ticks
+// are sent after Stop returns. Stop does not close C.
+func (tk *Ticker) Stop() {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	tk.halt()
+}
+
+// Reset stops the ticker and restarts it on the schedule of expr. It may be
+// used on a ticker that was stopped or whose schedule ended.
+func (tk *Ticker) Reset(expr *Expression) {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	tk.halt()
+	tk.start(expr)
+}
+
+// Done returns a channel that is closed when the ticker stops, either through
+// Stop or because its schedule has no further instants. After Reset, Done
+// returns a new channel.
+func (tk *Ticker) Done() <-chan struct{} {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	return tk.done
+}
+
+// start launches the goroutine for expr. The caller holds tk.mu.
+func (tk *Ticker) start(expr *Expression) {
+	tk.stop = make(chan struct{})
+	tk.done = make(chan struct{})
+	go tk.run(expr, tk.stop, tk.done)
+}
+
+// halt stops the running goroutine, if any, and waits for it to exit. The
+// caller holds tk.mu.
+func (tk *Ticker) halt() {
+	if tk.stop == nil {
+		return
+	}
+	close(tk.stop)
+	<-tk.done
+	tk.stop = nil
+	select {
+	case <-tk.c:
+	default:
+	}
+}
+
+// run waits for each instant of expr in turn and delivers it.
+func (tk *Ticker) run(expr *Expression, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	from := tk.clock.Now()
+	for {
+		next := expr.Next(from)
+		if next.IsZero() {
+			return
+		}
+		timer := tk.clock.NewTimer(next.Sub(tk.clock.Now()))
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+		tk.send(next)
+		// Instants that passed while this tick was waiting to be sent, or
+		// while the process was suspended, are skipped.
+		from = next
+		if now := tk.clock.Now(); now.After(from) {
+			from = now
+		}
+	}
+}
+
+// send delivers t without blocking, applying the tick policy when the
+// previous tick is still pending.
+func (tk *Ticker) send(t time.Time) {
+	select {
+	case tk.c <- t:
+		return
+	default:
+	}
+	if tk.policy != CoalesceTicks {
+		return
+	}
+	select {
+	case <-tk.c:
+	default:
+	}
+	select {
+	case tk.c <- t:
+	default:
+	}
+}
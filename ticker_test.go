@@ -0,0 +1,96 @@
+package cronexpr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toba/cronexpr"
+	"github.com/toba/cronexpr/cronexprtest"
+)
+
+func TestTicker(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := cronexprtest.NewFakeClock(start)
+	tk := cronexpr.NewTicker(cronexpr.MustParse("*/15 * * * *"), cronexpr.WithClock(clock))
+	defer tk.Stop()
+
+	for i := 1; i <= 3; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(15 * time.Minute)
+		want := start.Add(time.Duration(i) * 15 * time.Minute)
+		if got := <-tk.C; !got.Equal(want) {
+			t.Errorf("tick %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestTicker_SlowReader(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		policy cronexpr.TickPolicy
+		want   time.Time
+	}{
+		{"Drop", cronexpr.DropTicks, start.Add(15 * time.Minute)},
+		{"Coalesce", cronexpr.CoalesceTicks, start.Add(45 * time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := cronexprtest.NewFakeClock(start)
+			tk := cronexpr.NewTicker(cronexpr.MustParse("*/15 * * * *"),
+				cronexpr.WithClock(clock), cronexpr.WithTickPolicy(tt.policy))
+			defer tk.Stop()
+
+			for range 3 {
+				clock.BlockUntil(1)
+				clock.Advance(15 * time.Minute)
+			}
+			clock.BlockUntil(1)
+			if got := <-tk.C; !got.Equal(tt.want) {
+				t.Errorf("pending tick = %v, want %v", got, tt.want)
+			}
+			select {
+			case got := <-tk.C:
+				t.Errorf("unexpected second tick %v", got)
+			default:
+			}
+		})
+	}
+}
+
+func TestTicker_EndOfSchedule(t *testing.T) {
+	clock := cronexprtest.NewFakeClock(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	tk := cronexpr.NewTicker(cronexpr.MustParse("0 0 1 1 * 2026"), cronexpr.WithClock(clock))
+	defer tk.Stop()
+
+	clock.BlockUntil(1)
+	clock.Advance(24 * time.Hour)
+	if got, want := <-tk.C, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("tick = %v, want %v", got, want)
+	}
+	<-tk.Done()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Pending() = %d after schedule ended, want 0", n)
+	}
+}
+
+func TestTicker_StopAndReset(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := cronexprtest.NewFakeClock(start)
+	tk := cronexpr.NewTicker(cronexpr.MustParse("0 * * * *"), cronexpr.WithClock(clock))
+
+	clock.BlockUntil(1)
+	tk.Stop()
+	<-tk.Done()
+	if n := clock.Pending(); n != 0 {
+		t.Fatalf("Pending() = %d after Stop, want 0", n)
+	}
+
+	tk.Reset(cronexpr.MustParse("*/5 * * * *"))
+	defer tk.Stop()
+	clock.BlockUntil(1)
+	clock.Advance(5 * time.Minute)
+	if got, want := <-tk.C, start.Add(5*time.Minute); !got.Equal(want) {
+		t.Errorf("tick after Reset = %v, want %v", got, want)
+	}
+}